@@ -8,16 +8,20 @@ import (
 	"io"
 	"io/ioutil"
 	"log"
+	"net"
 	"os"
 	"os/user"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/baidubce/bce-sdk-go/util"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/mitchellh/go-homedir"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
 
 // DefaultTimeout timeout for common product, bcc e.g.
@@ -29,6 +33,41 @@ const (
 	PaymentTimingPrepai   = "Prepaid"
 )
 
+// mutexKV is a keyed mutex, which serializes the operations on the same cloud resource across terraform resources
+type mutexKV struct {
+	lock  sync.Mutex
+	store map[string]*sync.Mutex
+}
+
+func newMutexKV() *mutexKV {
+	return &mutexKV{
+		store: make(map[string]*sync.Mutex),
+	}
+}
+
+func (m *mutexKV) Lock(key string) {
+	log.Printf("[DEBUG] Locking %q", key)
+	m.get(key).Lock()
+	log.Printf("[DEBUG] Locked %q", key)
+}
+
+func (m *mutexKV) Unlock(key string) {
+	log.Printf("[DEBUG] Unlocking %q", key)
+	m.get(key).Unlock()
+	log.Printf("[DEBUG] Unlocked %q", key)
+}
+
+func (m *mutexKV) get(key string) *sync.Mutex {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	mutex, ok := m.store[key]
+	if !ok {
+		mutex = &sync.Mutex{}
+		m.store[key] = mutex
+	}
+	return mutex
+}
+
 func debugOn() bool {
 	for _, part := range strings.Split(os.Getenv("DEBUG"), ",") {
 		if strings.TrimSpace(part) == "terraform" {
@@ -46,6 +85,7 @@ func addDebug(action, content interface{}) {
 			trace += fmt.Sprintf("%s:%d\n", filepath, line)
 		}
 
+		content = connectivity.RedactDebugContent(content)
 		fmt.Printf(DefaultDebugMsg, action, content, trace)
 		log.Printf(DefaultDebugMsg, action, content, trace)
 	}
@@ -123,6 +163,16 @@ func buildStateConf(pending, target []string, timeout time.Duration, f resource.
 	}
 }
 
+// cidrOverlaps reports whether the two cidr blocks share any address, malformed cidr blocks never overlap
+func cidrOverlaps(a, b string) bool {
+	_, netA, errA := net.ParseCIDR(a)
+	_, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
+
 func stringInSlice(strs []string, value string) bool {
 	for _, str := range strs {
 		if value == str {
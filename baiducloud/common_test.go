@@ -1,5 +1,10 @@
 package baiducloud
 
+import (
+	"testing"
+	"time"
+)
+
 const (
 	BaiduCloudTestResourceName              = "default"
 	BaiduCloudTestResourceTypeName          = "tf-test-acc"
@@ -39,3 +44,61 @@ const (
 	BaiduCloudTestResourceTypeNameSubnet              = BaiduCloudTestResourceTypeName + "-" + "subnet"
 	BaiduCloudTestResourceTypeNameVpc                 = BaiduCloudTestResourceTypeName + "-" + "vpc"
 )
+
+func TestCidrOverlaps(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"192.168.0.0/16", "192.168.0.0/16", true},
+		{"192.168.0.0/16", "192.168.1.0/24", true},
+		{"192.168.1.0/24", "192.168.0.0/16", true},
+		{"192.168.0.0/16", "172.16.0.0/16", false},
+		{"192.168.1.0/24", "192.168.2.0/24", false},
+		{"invalid", "192.168.0.0/16", false},
+		{"", "192.168.0.0/16", false},
+	}
+
+	for _, c := range cases {
+		if got := cidrOverlaps(c.a, c.b); got != c.expected {
+			t.Errorf("cidrOverlaps(%q, %q): expected %t, got %t", c.a, c.b, c.expected, got)
+		}
+	}
+}
+
+func TestMutexKV(t *testing.T) {
+	m := newMutexKV()
+
+	m.Lock("a")
+	// a different key is not blocked
+	done := make(chan struct{})
+	go func() {
+		m.Lock("b")
+		m.Unlock("b")
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("locking another key is blocked")
+	}
+
+	// the same key is blocked until it is unlocked
+	locked := make(chan struct{})
+	go func() {
+		m.Lock("a")
+		close(locked)
+		m.Unlock("a")
+	}()
+	select {
+	case <-locked:
+		t.Fatalf("locking the same key is not blocked")
+	case <-time.After(50 * time.Millisecond):
+	}
+	m.Unlock("a")
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatalf("locking the same key is still blocked after unlocking")
+	}
+}
@@ -1,9 +1,12 @@
 package connectivity
 
 import (
+	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/baidubce/bce-sdk-go/auth"
+	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/appblb"
 	"github.com/baidubce/bce-sdk-go/services/bcc"
 	"github.com/baidubce/bce-sdk-go/services/bos"
@@ -43,6 +46,7 @@ type BaiduClient struct {
 	rdsConn    *rds.Client
 	dtsConn    *dts.Client
 	iamConn    *iam.Client
+	stsConn    *sts.Client
 }
 
 type ApiVersion string
@@ -56,11 +60,17 @@ func (c *Config) Client() (*BaiduClient, error) {
 		Region: c.Region,
 	}
 
+	credentials, err := c.credentials()
+	if err != nil {
+		return nil, err
+	}
+
 	if c.AssumeRoleAccountId != "" && c.AssumeRoleRoleName != "" {
 		stsClient, err := sts.NewClient(c.AccessKey, c.SecretKey)
 		if err != nil {
 			return nil, err
 		}
+		stsClient.Config.Credentials = credentials
 
 		args := &api.AssumeRoleArgs{
 			AccountId: c.AssumeRoleAccountId,
@@ -83,17 +93,79 @@ func (c *Config) Client() (*BaiduClient, error) {
 
 		client.Credentials = stsCredential
 	} else {
-		credentials, err := auth.NewBceCredentials(c.AccessKey, c.SecretKey)
-		if err != nil {
-			return nil, err
-		}
-
 		client.Credentials = credentials
 	}
 
 	return client, nil
 }
 
+// credentials builds the credentials from the access key, which are session credentials if the security token is set
+func (c *Config) credentials() (*auth.BceCredentials, error) {
+	if c.SecurityToken != "" {
+		return auth.NewSessionBceCredentials(c.AccessKey, c.SecretKey, c.SecurityToken)
+	}
+	return auth.NewBceCredentials(c.AccessKey, c.SecretKey)
+}
+
+// retryPolicy builds the retry policy of the sdk clients. The retry_interval applies even if max_retries is not set,
+// in which case the sdk default number of retries is used, so neither set is the same as the sdk default policy.
+func (client *BaiduClient) retryPolicy() bce.RetryPolicy {
+	maxRetries := DefaultMaxRetries
+	if client.config.MaxRetries != nil {
+		maxRetries = *client.config.MaxRetries
+	}
+	if maxRetries == 0 {
+		return bce.NewNoRetryPolicy()
+	}
+
+	interval := int64(client.config.RetryInterval)
+	if interval <= 0 {
+		interval = DefaultRetryIntervalInMillis
+	}
+	maxDelay := int64(DefaultMaxRetryDelayInMillis)
+	if interval > maxDelay {
+		maxDelay = interval
+	}
+
+	return bce.NewBackOffRetryPolicy(maxRetries, maxDelay, interval)
+}
+
+// connectionTimeout returns the timeout in milliseconds of a request of the sdk clients, the sdk default is used
+// if it is not configured. All the sdk clients send requests by a shared http transport, so connections are
+// pooled across clients regardless of the timeout.
+func (client *BaiduClient) connectionTimeout() int {
+	if client.config.ConnectionTimeout <= 0 {
+		return bce.DEFAULT_CONNECTION_TIMEOUT_IN_MILLIS
+	}
+	return client.config.ConnectionTimeout
+}
+
+// IgnoreTag reports whether the tag key is ignored by the ignore_tags block of the provider
+func (client *BaiduClient) IgnoreTag(key string) bool {
+	for _, k := range client.config.IgnoreTagKeys {
+		if k == key {
+			return true
+		}
+	}
+	for _, prefix := range client.config.IgnoreTagKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// checkRegion checks the region of the client is known before creating the client of the service,
+// any region is accepted if the endpoint of the service is customized
+func (client *BaiduClient) checkRegion(serviceCode ServiceCode) error {
+	region := client.config.Region
+	if region == "" || IsKnownRegion(region) || HasCustomEndpoint(region, serviceCode, client.config.ConfigEndpoints) {
+		return nil
+	}
+	return fmt.Errorf("region %q is not supported by %s, valid regions are %s, or customize the endpoint of %s",
+		region, serviceCode, strings.Join(ValidRegions(), ", "), serviceCode)
+}
+
 func (client *BaiduClient) WithCommonClient(serviceCode ServiceCode) *BaiduClient {
 	log.SetLogLevel(log.DEBUG)
 	log.SetLogHandler(log.NONE)
@@ -122,17 +194,24 @@ func (client *BaiduClient) WithBccClient(do func(*bcc.Client) (interface{}, erro
 
 	// Initialize the BCC client if necessary
 	if client.bccConn == nil {
+		if err := client.checkRegion(BCCCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(BCCCode)
 		bccClient, err := bcc.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		bccClient.Config.Credentials = client.Credentials
+		bccClient.Config.Retry = client.retryPolicy()
+		bccClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.bccConn = bccClient
 	}
 
-	return do(client.bccConn)
+	raw, err := do(client.bccConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithVpcClient(do func(*vpc.Client) (interface{}, error)) (interface{}, error) {
@@ -141,17 +220,24 @@ func (client *BaiduClient) WithVpcClient(do func(*vpc.Client) (interface{}, erro
 
 	// Initialize the VPC client if necessary
 	if client.vpcConn == nil {
+		if err := client.checkRegion(VPCCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(VPCCode)
 		vpcClient, err := vpc.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		vpcClient.Config.Credentials = client.Credentials
+		vpcClient.Config.Retry = client.retryPolicy()
+		vpcClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.vpcConn = vpcClient
 	}
 
-	return do(client.vpcConn)
+	raw, err := do(client.vpcConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithEipClient(do func(*eip.Client) (interface{}, error)) (interface{}, error) {
@@ -160,17 +246,24 @@ func (client *BaiduClient) WithEipClient(do func(*eip.Client) (interface{}, erro
 
 	// Initialize the EIP client if necessary
 	if client.eipConn == nil {
+		if err := client.checkRegion(EIPCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(EIPCode)
 		eipClient, err := eip.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		eipClient.Config.Credentials = client.Credentials
+		eipClient.Config.Retry = client.retryPolicy()
+		eipClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.eipConn = eipClient
 	}
 
-	return do(client.eipConn)
+	raw, err := do(client.eipConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithAppBLBClient(do func(*appblb.Client) (interface{}, error)) (interface{}, error) {
@@ -179,17 +272,24 @@ func (client *BaiduClient) WithAppBLBClient(do func(*appblb.Client) (interface{}
 
 	// Initialize the APPBLB client if necessary
 	if client.appBlbConn == nil {
+		if err := client.checkRegion(APPBLBCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(APPBLBCode)
 		appBlbClient, err := appblb.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		appBlbClient.Config.Credentials = client.Credentials
+		appBlbClient.Config.Retry = client.retryPolicy()
+		appBlbClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.appBlbConn = appBlbClient
 	}
 
-	return do(client.appBlbConn)
+	raw, err := do(client.appBlbConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithBosClient(do func(*bos.Client) (interface{}, error)) (interface{}, error) {
@@ -198,17 +298,24 @@ func (client *BaiduClient) WithBosClient(do func(*bos.Client) (interface{}, erro
 
 	// Initialize the BOS client if necessary
 	if client.bosConn == nil {
+		if err := client.checkRegion(BOSCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(BOSCode)
 		bosClient, err := bos.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		bosClient.Config.Credentials = client.Credentials
+		bosClient.Config.Retry = client.retryPolicy()
+		bosClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.bosConn = bosClient
 	}
 
-	return do(client.bosConn)
+	raw, err := do(client.bosConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithCertClient(do func(*cert.Client) (interface{}, error)) (interface{}, error) {
@@ -217,17 +324,24 @@ func (client *BaiduClient) WithCertClient(do func(*cert.Client) (interface{}, er
 
 	// Initialize the CERT client if necessary
 	if client.certConn == nil {
+		if err := client.checkRegion(CERTCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(CERTCode)
 		certClient, err := cert.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		certClient.Config.Credentials = client.Credentials
+		certClient.Config.Retry = client.retryPolicy()
+		certClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.certConn = certClient
 	}
 
-	return do(client.certConn)
+	raw, err := do(client.certConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithCFCClient(do func(*cfc.Client) (interface{}, error)) (interface{}, error) {
@@ -236,17 +350,24 @@ func (client *BaiduClient) WithCFCClient(do func(*cfc.Client) (interface{}, erro
 
 	// Initialize the CFC client if necessary
 	if client.cfcConn == nil {
+		if err := client.checkRegion(CFCCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(CFCCode)
 		cfcClient, err := cfc.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		cfcClient.Config.Credentials = client.Credentials
+		cfcClient.Config.Retry = client.retryPolicy()
+		cfcClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.cfcConn = cfcClient
 	}
 
-	return do(client.cfcConn)
+	raw, err := do(client.cfcConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithScsClient(do func(*scs.Client) (interface{}, error)) (interface{}, error) {
@@ -255,17 +376,24 @@ func (client *BaiduClient) WithScsClient(do func(*scs.Client) (interface{}, erro
 
 	// Initialize the SCS client if necessary
 	if client.scsConn == nil {
+		if err := client.checkRegion(SCSCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(SCSCode)
 		scsClient, err := scs.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		scsClient.Config.Credentials = client.Credentials
+		scsClient.Config.Retry = client.retryPolicy()
+		scsClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.scsConn = scsClient
 	}
 
-	return do(client.scsConn)
+	raw, err := do(client.scsConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithCCEClient(do func(*cce.Client) (interface{}, error)) (interface{}, error) {
@@ -274,17 +402,24 @@ func (client *BaiduClient) WithCCEClient(do func(*cce.Client) (interface{}, erro
 
 	// Initialize the CCE client if necessary
 	if client.cceConn == nil {
+		if err := client.checkRegion(CCECode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(CCECode)
 		cceClient, err := cce.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		cceClient.Config.Credentials = client.Credentials
+		cceClient.Config.Retry = client.retryPolicy()
+		cceClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.cceConn = cceClient
 	}
 
-	return do(client.cceConn)
+	raw, err := do(client.cceConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithCCEv2Client(do func(*ccev2.Client) (interface{}, error)) (interface{}, error) {
@@ -293,17 +428,24 @@ func (client *BaiduClient) WithCCEv2Client(do func(*ccev2.Client) (interface{},
 
 	// Initialize the CCEv2 client if necessary
 	if client.ccev2Conn == nil {
+		if err := client.checkRegion(CCEv2Code); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(CCEv2Code)
 		ccev2Client, err := ccev2.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		ccev2Client.Config.Credentials = client.Credentials
+		ccev2Client.Config.Retry = client.retryPolicy()
+		ccev2Client.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.ccev2Conn = ccev2Client
 	}
 
-	return do(client.ccev2Conn)
+	raw, err := do(client.ccev2Conn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithRdsClient(do func(*rds.Client) (interface{}, error)) (interface{}, error) {
@@ -312,17 +454,24 @@ func (client *BaiduClient) WithRdsClient(do func(*rds.Client) (interface{}, erro
 
 	// Initialize the RDS client if necessary
 	if client.rdsConn == nil {
+		if err := client.checkRegion(RDSCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(RDSCode)
 		rdsClient, err := rds.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
 		rdsClient.Config.Credentials = client.Credentials
+		rdsClient.Config.Retry = client.retryPolicy()
+		rdsClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
 
 		client.rdsConn = rdsClient
 	}
 
-	return do(client.rdsConn)
+	raw, err := do(client.rdsConn)
+	client.debugResponse(raw, err)
+	return raw, err
 
 }
 
@@ -332,15 +481,23 @@ func (client *BaiduClient) WithDtsClient(do func(*dts.Client) (interface{}, erro
 
 	// Initialize the DTS client if necessary
 	if client.dtsConn == nil {
+		if err := client.checkRegion(DTSCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(DTSCode)
 		dtsClient, err := dts.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey, client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
+		dtsClient.Config.Retry = client.retryPolicy()
+		dtsClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
+
 		client.dtsConn = dtsClient
 	}
 
-	return do(client.dtsConn)
+	raw, err := do(client.dtsConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
 
 func (client *BaiduClient) WithIamClient(do func(*iam.Client) (interface{}, error)) (interface{}, error) {
@@ -349,14 +506,44 @@ func (client *BaiduClient) WithIamClient(do func(*iam.Client) (interface{}, erro
 
 	// Initialize the IAM client if necessary
 	if client.iamConn == nil {
+		if err := client.checkRegion(IAMCode); err != nil {
+			return nil, err
+		}
 		client.WithCommonClient(IAMCode)
 		iamClient, err := iam.NewClientWithEndpoint(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey,
 			client.Endpoint)
 		if err != nil {
 			return nil, err
 		}
+		iamClient.Config.Retry = client.retryPolicy()
+		iamClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
+
 		client.iamConn = iamClient
 	}
 
-	return do(client.iamConn)
+	raw, err := do(client.iamConn)
+	client.debugResponse(raw, err)
+	return raw, err
+}
+
+func (client *BaiduClient) WithStsClient(do func(*sts.Client) (interface{}, error)) (interface{}, error) {
+	goSdkMutex.Lock()
+	defer goSdkMutex.Unlock()
+
+	// Initialize the STS client if necessary
+	if client.stsConn == nil {
+		stsClient, err := sts.NewClient(client.Credentials.AccessKeyId, client.Credentials.SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+		stsClient.Config.Credentials = client.Credentials
+		stsClient.Config.Retry = client.retryPolicy()
+		stsClient.Config.ConnectionTimeoutInMillis = client.connectionTimeout()
+
+		client.stsConn = stsClient
+	}
+
+	raw, err := do(client.stsConn)
+	client.debugResponse(raw, err)
+	return raw, err
 }
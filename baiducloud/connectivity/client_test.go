@@ -0,0 +1,71 @@
+package connectivity
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+)
+
+func TestBaiduClientRetryPolicy(t *testing.T) {
+	zero := 0
+	five := 5
+	retryErr := &bce.BceServiceError{Code: "ServiceUnavailable", StatusCode: http.StatusServiceUnavailable}
+	cases := []struct {
+		config     *Config
+		maxRetries int
+		firstDelay time.Duration
+	}{
+		{config: &Config{}, maxRetries: DefaultMaxRetries, firstDelay: 2 * DefaultRetryIntervalInMillis * time.Millisecond},
+		{config: &Config{RetryInterval: 1000}, maxRetries: DefaultMaxRetries, firstDelay: 2 * time.Second},
+		{config: &Config{MaxRetries: &five, RetryInterval: 1000}, maxRetries: 5, firstDelay: 2 * time.Second},
+		{config: &Config{MaxRetries: &zero, RetryInterval: 1000}, maxRetries: 0},
+	}
+
+	for _, tc := range cases {
+		policy := (&BaiduClient{config: tc.config}).retryPolicy()
+		name := fmt.Sprintf("%+v", tc)
+
+		if tc.maxRetries == 0 {
+			if policy.ShouldRetry(retryErr, 0) {
+				t.Fatalf("%s: expected no retry", name)
+			}
+			continue
+		}
+		if !policy.ShouldRetry(retryErr, tc.maxRetries-1) || policy.ShouldRetry(retryErr, tc.maxRetries) {
+			t.Fatalf("%s: expected %d retries", name, tc.maxRetries)
+		}
+		if delay := policy.GetDelayBeforeNextRetryInMillis(retryErr, 1); delay != tc.firstDelay {
+			t.Fatalf("%s: expected delay %s, got %s", name, tc.firstDelay, delay)
+		}
+	}
+}
+
+func TestBaiduClientCheckRegion(t *testing.T) {
+	t.Setenv("BCC_ENDPOINT", "")
+	t.Setenv("SCS_ENDPOINT", "")
+	client := &BaiduClient{config: &Config{
+		Region:          Region("private"),
+		ConfigEndpoints: ConfigEndpoints{SCSCode: "redis.private.example.com"},
+	}}
+
+	// only the services with a custom endpoint skip the check of an unknown region
+	if err := client.checkRegion(SCSCode); err != nil {
+		t.Fatalf("expected scs with a custom endpoint to be allowed, got %v", err)
+	}
+	if err := client.checkRegion(BCCCode); err == nil {
+		t.Fatalf("expected bcc without a custom endpoint to be rejected")
+	}
+
+	t.Setenv("BCC_ENDPOINT", "bcc.private.example.com")
+	if err := client.checkRegion(BCCCode); err != nil {
+		t.Fatalf("expected bcc with an endpoint from the environment to be allowed, got %v", err)
+	}
+
+	known := &BaiduClient{config: &Config{Region: RegionHongKong}}
+	if err := known.checkRegion(BCCCode); err != nil {
+		t.Fatalf("expected a known region to be allowed, got %v", err)
+	}
+}
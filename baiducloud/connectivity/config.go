@@ -3,6 +3,10 @@ package connectivity
 // Config Constants
 const (
 	LogDir = "./logs/"
+
+	DefaultMaxRetries            = 3
+	DefaultRetryIntervalInMillis = 300
+	DefaultMaxRetryDelayInMillis = 20000
 )
 
 // Config Service Endpoints
@@ -14,12 +18,29 @@ type Config struct {
 	SecretKey string
 	Region    Region
 
+	// session token of the sts temporary credentials
+	SecurityToken string
+
 	// assume role
 	AssumeRoleRoleName  string
 	AssumeRoleAccountId string
 	AssumeRoleUserId    string
 	AssumeRoleAcl       string
 
+	// retry of the sdk clients, nil MaxRetries means the sdk default policy
+	MaxRetries    *int
+	RetryInterval int
+
+	// timeout in milliseconds of a request of the sdk clients, 0 means the sdk default
+	ConnectionTimeout int
+
+	// log every api action and its response with the sensitive fields redacted
+	Debug bool
+
+	// tags managed outside of terraform, which are neither read into the state nor modified
+	IgnoreTagKeys        []string
+	IgnoreTagKeyPrefixes []string
+
 	// Config Service Endpoints Map
 	ConfigEndpoints ConfigEndpoints
 }
@@ -0,0 +1,76 @@
+package connectivity
+
+import (
+	"encoding/json"
+	stdlog "log"
+	"runtime"
+	"strings"
+)
+
+// debugFields are lowercase substrings of the field names whose values are redacted from the debug output
+var debugFields = []string{"password", "secret", "token", "credential"}
+
+// Debug reports whether the debug argument of the provider is set
+func (client *BaiduClient) Debug() bool {
+	return client.config.Debug
+}
+
+// debugResponse logs the response of an api action sent by one of the WithXClient wrappers if the debug argument of
+// the provider is set, the action is named by the function which called the wrapper
+func (client *BaiduClient) debugResponse(raw interface{}, err error) {
+	if !client.Debug() {
+		return
+	}
+
+	action := "unknown"
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			action = fn.Name()
+		}
+	}
+
+	content := RedactDebugContent(raw)
+	if data, e := json.Marshal(content); e == nil {
+		content = string(data)
+	}
+	stdlog.Printf("[DEBUG] api action %s, response: %v, error: %v", action, content, err)
+}
+
+// RedactDebugContent converts the content to its json form and masks the sensitive fields,
+// content which can not be converted is returned as it is
+func RedactDebugContent(content interface{}) interface{} {
+	data, err := json.Marshal(content)
+	if err != nil {
+		return content
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return content
+	}
+	return redactDebugValue(value)
+}
+
+func redactDebugValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			sensitive := false
+			for _, field := range debugFields {
+				if strings.Contains(strings.ToLower(key), field) {
+					sensitive = true
+					break
+				}
+			}
+			if sensitive {
+				v[key] = "******"
+			} else {
+				v[key] = redactDebugValue(item)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactDebugValue(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,78 @@
+package connectivity
+
+import (
+	"bytes"
+	"fmt"
+	stdlog "log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/baidubce/bce-sdk-go/services/scs"
+)
+
+func TestRedactDebugContent(t *testing.T) {
+	args := &scs.CreateInstanceArgs{
+		InstanceName: "redis-test",
+		ClientToken:  "token-123",
+		Subnets:      []scs.Subnet{{SubnetID: "sbn-a", ZoneName: "cn-bj-a"}},
+	}
+	content := fmt.Sprintf("%+v", RedactDebugContent(map[string]interface{}{
+		"password": "Passw0rd!",
+		"args":     args,
+		"nested":   []interface{}{map[string]interface{}{"secretKey": "sk-123"}},
+	}))
+
+	for _, secret := range []string{"Passw0rd!", "sk-123"} {
+		if strings.Contains(content, secret) {
+			t.Fatalf("expected %s to be redacted, got %s", secret, content)
+		}
+	}
+	for _, plain := range []string{"redis-test", "sbn-a"} {
+		if !strings.Contains(content, plain) {
+			t.Fatalf("expected %s to be kept, got %s", plain, content)
+		}
+	}
+
+	if RedactDebugContent("plain text") != "plain text" {
+		t.Fatalf("expected a string content to be kept")
+	}
+}
+
+func TestBaiduClientDebugResponse(t *testing.T) {
+	var buf bytes.Buffer
+	stdlog.SetOutput(&buf)
+	defer stdlog.SetOutput(os.Stderr)
+
+	response := struct {
+		InstanceName string
+		Password     string
+		Account      map[string]interface{}
+	}{
+		InstanceName: "redis-test",
+		Password:     "Passw0rd!",
+		Account:      map[string]interface{}{"password": "s3cret!", "secretAccessKey": "sk-123", "sessionToken": "token-123"},
+	}
+	// the response is logged by a WithXClient wrapper, which is named as the action
+	withClient := func(client *BaiduClient) {
+		client.debugResponse(response, nil)
+	}
+
+	withClient(&BaiduClient{config: &Config{}})
+	if buf.Len() != 0 {
+		t.Fatalf("expected nothing to be logged without debug, got %s", buf.String())
+	}
+
+	withClient(&BaiduClient{config: &Config{Debug: true}})
+	output := buf.String()
+	for _, secret := range []string{"Passw0rd!", "s3cret!", "sk-123", "token-123"} {
+		if strings.Contains(output, secret) {
+			t.Fatalf("expected %s to be redacted, got %s", secret, output)
+		}
+	}
+	for _, plain := range []string{"redis-test", "TestBaiduClientDebugResponse", "******"} {
+		if !strings.Contains(output, plain) {
+			t.Fatalf("expected %s to be logged, got %s", plain, output)
+		}
+	}
+}
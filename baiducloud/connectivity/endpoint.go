@@ -27,6 +27,11 @@ const (
 	IAMCode    = ServiceCode("IAM")
 )
 
+// ServiceCodes are all the services whose endpoints can be customized
+var ServiceCodes = []ServiceCode{
+	BCCCode, VPCCode, EIPCode, APPBLBCode, BOSCode, CERTCode, CFCCode, CCECode, CCEv2Code, SCSCode, RDSCode, DTSCode, IAMCode,
+}
+
 const (
 	DefaultBJRegionBccEndPoint = "bcc.bj.baidubce.com"
 	DefaultBJRegionEipEndPoint = "eip.bj.baidubce.com"
@@ -144,7 +149,7 @@ var (
 	}
 )
 
-//xml
+// xml
 type Endpoints struct {
 	Endpoint []Endpoint `xml:"Endpoint"`
 }
@@ -209,3 +214,9 @@ func loadEndpoint(region Region, serviceCode ServiceCode) string {
 
 	return endpoint
 }
+
+// HasCustomEndpoint returns whether the endpoint of the service in the region is customized by the endpoints block,
+// the <SERVICE>_ENDPOINT environment variable or endpoints.xml, instead of taken from DefaultRegionEndpoints
+func HasCustomEndpoint(region Region, serviceCode ServiceCode, configEndpoints ConfigEndpoints) bool {
+	return configEndpoints[serviceCode] != "" || loadEndpointFromEnvOrXML(region, serviceCode) != ""
+}
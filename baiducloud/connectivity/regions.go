@@ -1,5 +1,7 @@
 package connectivity
 
+import "sort"
+
 // Region represents BCC region
 type Region string
 
@@ -13,4 +15,37 @@ const (
 	RegionSuZhou    = Region("su")
 	RegionGuangZhou = Region("gz")
 	RegionWuHan     = Region("fwh")
+	RegionHongKong  = Region("hkg")
+	RegionBaoDing   = Region("bd")
+	RegionShangHai  = Region("fsh")
+	RegionSingapore = Region("sin")
+	RegionYangQuan  = Region("yq")
+	RegionNanJing   = Region("nj")
+	RegionChengDu   = Region("cd")
 )
+
+// KnownRegions are all the regions of BaiduCloud, only some of them have default endpoints in DefaultRegionEndpoints
+var KnownRegions = []Region{
+	RegionBeiJing, RegionSuZhou, RegionGuangZhou, RegionWuHan, RegionHongKong, RegionBaoDing,
+	RegionShangHai, RegionSingapore, RegionYangQuan, RegionNanJing, RegionChengDu,
+}
+
+// ValidRegions returns the known regions sorted by name
+func ValidRegions() []string {
+	regions := make([]string, 0, len(KnownRegions))
+	for _, region := range KnownRegions {
+		regions = append(regions, string(region))
+	}
+	sort.Strings(regions)
+	return regions
+}
+
+// IsKnownRegion returns whether the region is one of KnownRegions
+func IsKnownRegion(region Region) bool {
+	for _, known := range KnownRegions {
+		if region == known {
+			return true
+		}
+	}
+	return false
+}
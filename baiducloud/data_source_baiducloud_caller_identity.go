@@ -0,0 +1,85 @@
+/*
+Use this data source to query the identity of the credentials used by the provider and the configured region.
+
+Example Usage
+
+```hcl
+data "baiducloud_caller_identity" "current" {}
+
+output "user_id" {
+  value = "${data.baiducloud_caller_identity.current.user_id}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/baidubce/bce-sdk-go/services/sts"
+	"github.com/baidubce/bce-sdk-go/services/sts/api"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudCallerIdentity() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudCallerIdentityRead,
+
+		Schema: map[string]*schema.Schema{
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			// Attributes used for result
+			"user_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the user who owns the access key. It is the account id if the access key belongs to the root account.",
+				Computed:    true,
+			},
+			"region": {
+				Type:        schema.TypeString,
+				Description: "Region configured in the provider.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudCallerIdentityRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	action := "Query caller identity"
+	// sts has no api to query the identity directly, the session token tells the user who requests it
+	raw, err := client.WithStsClient(func(stsClient *sts.Client) (interface{}, error) {
+		return stsClient.GetSessionToken(0, "")
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_caller_identity", action, BCESDKGoERROR)
+	}
+
+	result := raw.(*api.GetSessionTokenResult)
+	region := string(client.Region)
+	if region == "" {
+		region = string(connectivity.DefaultRegion)
+	}
+	identity := map[string]interface{}{
+		"user_id": result.UserId,
+		"region":  region,
+	}
+	addDebug(action, identity)
+
+	d.Set("user_id", result.UserId)
+	d.Set("region", region)
+	d.SetId(result.UserId)
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), identity); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_caller_identity", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
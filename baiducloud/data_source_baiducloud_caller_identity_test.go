@@ -0,0 +1,32 @@
+package baiducloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const testAccCallerIdentityDataSourceName = "data.baiducloud_caller_identity.default"
+
+func TestAccBaiduCloudCallerIdentityDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCallerIdentityDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccCallerIdentityDataSourceName),
+					resource.TestCheckResourceAttrSet(testAccCallerIdentityDataSourceName, "user_id"),
+					resource.TestCheckResourceAttrSet(testAccCallerIdentityDataSourceName, "region"),
+				),
+			},
+		},
+	})
+}
+
+const testAccCallerIdentityDataSourceConfig = `
+data "baiducloud_caller_identity" "default" {}
+`
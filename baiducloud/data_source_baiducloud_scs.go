@@ -0,0 +1,224 @@
+/*
+Use this data source to query a SCS instance by its id.
+
+Example Usage
+
+```hcl
+data "baiducloud_scs" "default" {
+  instance_id = "scs-bj-xxxxxxxx"
+}
+
+output "connection_string" {
+  value = "${data.baiducloud_scs.default.connection_string}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"fmt"
+
+	"github.com/baidubce/bce-sdk-go/services/scs"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudScs() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudScsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file of the instance search result",
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			"instance_name": {
+				Type:        schema.TypeString,
+				Description: "Name of the instance.",
+				Computed:    true,
+			},
+			"instance_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the instance.",
+				Computed:    true,
+			},
+			"cluster_type": {
+				Type:        schema.TypeString,
+				Description: "Type of the instance,  Available values are cluster, master_slave.",
+				Computed:    true,
+			},
+			"engine": {
+				Type:        schema.TypeString,
+				Description: "Engine of the instance. Available values are redis, memcache.",
+				Computed:    true,
+			},
+			"engine_version": {
+				Type:        schema.TypeString,
+				Description: "Engine version of the instance. Available values are 3.2, 4.0.",
+				Computed:    true,
+			},
+			"v_net_ip": {
+				Type:        schema.TypeString,
+				Description: "The internal ip used to access a instance.",
+				Computed:    true,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "Domain of the instance.",
+				Computed:    true,
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Description: "The port used to access a instance.",
+				Computed:    true,
+			},
+			"connection_string": {
+				Type:        schema.TypeString,
+				Description: "Address to connect the instance, in the format domain:port. For cluster instances it is the address of the proxy.",
+				Computed:    true,
+			},
+			"create_time": {
+				Type:        schema.TypeString,
+				Description: "Create time of the instance.",
+				Computed:    true,
+			},
+			"expire_time": {
+				Type:        schema.TypeString,
+				Description: "Expire time of the instance.",
+				Computed:    true,
+			},
+			"capacity": {
+				Type:        schema.TypeInt,
+				Description: "Memory capacity(GB) of the instance.",
+				Computed:    true,
+			},
+			"used_capacity": {
+				Type:        schema.TypeInt,
+				Description: "Memory capacity(GB) of the instance to be used.",
+				Computed:    true,
+			},
+			"memory_usage_ratio": {
+				Type:        schema.TypeFloat,
+				Description: "Ratio of used_capacity to capacity rounded to two decimals, such as 0.25. It is 0 if the capacity is unknown.",
+				Computed:    true,
+			},
+			"payment_timing": {
+				Type:        schema.TypeString,
+				Description: "SCS payment timing",
+				Computed:    true,
+			},
+			"auto_renew": {
+				Type:        schema.TypeBool,
+				Description: "Whether to automatically renew.",
+				Computed:    true,
+			},
+			"vpc_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the VPC which the instance belongs to.",
+				Computed:    true,
+			},
+			"subnets": {
+				Type:        schema.TypeList,
+				Description: "Subnets of the instance.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the subnet.",
+							Computed:    true,
+						},
+						"zone_name": {
+							Type:        schema.TypeString,
+							Description: "Zone name of the subnet.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"zone_names": {
+				Type:        schema.TypeList,
+				Description: "Zone name list",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags": tagsComputedSchema(),
+		},
+	}
+}
+
+func dataSourceBaiduCloudScsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	instanceID := d.Get("instance_id").(string)
+	action := "Query SCS Instance " + instanceID
+
+	result, err := scsService.GetInstanceDetail(instanceID)
+	if err != nil {
+		if NotFoundError(err) {
+			return WrapErrorf(Error("SCS instance %s is not found", instanceID), DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	scsMap := flattenScsInstanceDetail(client, result)
+	addDebug(action, scsMap)
+
+	for key, value := range scsMap {
+		if key == "instance_id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+	}
+	d.SetId(instanceID)
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), scsMap); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
+
+// flattenScsInstanceDetail converts the detail of the instance to the attributes of the data source
+func flattenScsInstanceDetail(client *connectivity.BaiduClient, result *scs.GetInstanceDetailResult) map[string]interface{} {
+	return map[string]interface{}{
+		"instance_id":        result.InstanceID,
+		"instance_name":      result.InstanceName,
+		"instance_status":    result.InstanceStatus,
+		"cluster_type":       result.ClusterType,
+		"engine":             result.Engine,
+		"engine_version":     result.EngineVersion,
+		"v_net_ip":           result.VnetIP,
+		"domain":             result.Domain,
+		"port":               result.Port,
+		"connection_string":  fmt.Sprintf("%s:%d", result.Domain, result.Port),
+		"create_time":        result.InstanceCreateTime,
+		"expire_time":        result.InstanceExpireTime,
+		"capacity":           result.Capacity,
+		"used_capacity":      result.UsedCapacity,
+		"memory_usage_ratio": scsMemoryUsageRatio(result.UsedCapacity, result.Capacity),
+		"payment_timing":     result.PaymentTiming,
+		"auto_renew":         scsAutoRenew(result.AutoRenew),
+		"vpc_id":             result.VpcID,
+		"subnets":            transSubnetsToSchema(result.Subnets),
+		"zone_names":         result.ZoneNames,
+		"tags":               flattenTagsToMap(client, result.Tags),
+	}
+}
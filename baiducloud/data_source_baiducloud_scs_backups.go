@@ -0,0 +1,199 @@
+/*
+Use this data source to query the backups of a SCS instance.
+
+Example Usage
+
+```hcl
+data "baiducloud_scs_backups" "default" {
+  instance_id = "scs-bj-xxxxxxxx"
+  start_time  = "2021-08-01T00:00:00Z"
+}
+
+output "backups" {
+  value = "${data.baiducloud_scs_backups.default.backups}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudScsBackups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudScsBackupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the SCS instance.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Description:  "Only the backups started after this time are returned, in UTC format such as 2021-08-01T00:00:00Z.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Description:  "Only the backups started before this time are returned, in UTC format such as 2021-08-31T00:00:00Z.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file of the backups search result",
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			"total_count": {
+				Type:        schema.TypeInt,
+				Description: "Total count of the backups searched.",
+				Computed:    true,
+			},
+			"backups": {
+				Type:        schema.TypeList,
+				Description: "The result of the backups list.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the backup, such as manual, auto.",
+							Computed:    true,
+						},
+						"comment": {
+							Type:        schema.TypeString,
+							Description: "Comment of the backup.",
+							Computed:    true,
+						},
+						"start_time": {
+							Type:        schema.TypeString,
+							Description: "Start time of the backup.",
+							Computed:    true,
+						},
+						"records": {
+							Type:        schema.TypeList,
+							Description: "Backup records of the shards.",
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backup_record_id": {
+										Type:        schema.TypeString,
+										Description: "ID of the backup record.",
+										Computed:    true,
+									},
+									"backup_status": {
+										Type:        schema.TypeString,
+										Description: "Status of the backup record.",
+										Computed:    true,
+									},
+									"duration": {
+										Type:        schema.TypeString,
+										Description: "Duration of the backup.",
+										Computed:    true,
+									},
+									"object_size": {
+										Type:        schema.TypeString,
+										Description: "Size of the backup file in bytes.",
+										Computed:    true,
+									},
+									"shard_name": {
+										Type:        schema.TypeString,
+										Description: "Name of the shard.",
+										Computed:    true,
+									},
+									"start_time": {
+										Type:        schema.TypeString,
+										Description: "Start time of the backup record.",
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudScsBackupsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	instanceID := d.Get("instance_id").(string)
+	action := "List SCS backups " + instanceID
+
+	result, err := scsService.GetBackupList(instanceID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_backups", action, BCESDKGoERROR)
+	}
+
+	var startTime, endTime time.Time
+	if v, ok := d.GetOk("start_time"); ok {
+		startTime, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	if v, ok := d.GetOk("end_time"); ok {
+		endTime, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	backups := make([]map[string]interface{}, 0, len(result.Backups))
+	for _, backup := range result.Backups {
+		// keep the backup if its start time can not be parsed, rather than dropping it silently
+		if backupTime, err := time.Parse(time.RFC3339, backup.StartTime); err == nil {
+			if !startTime.IsZero() && backupTime.Before(startTime) {
+				continue
+			}
+			if !endTime.IsZero() && backupTime.After(endTime) {
+				continue
+			}
+		}
+
+		records := make([]map[string]interface{}, 0, len(backup.Records))
+		for _, record := range backup.Records {
+			records = append(records, map[string]interface{}{
+				"backup_record_id": record.BackupRecordId,
+				"backup_status":    record.BackupStatus,
+				"duration":         record.Duration,
+				"object_size":      record.ObjectSize,
+				"shard_name":       record.ShardName,
+				"start_time":       record.StartTime,
+			})
+		}
+
+		backups = append(backups, map[string]interface{}{
+			"backup_type": backup.BackupType,
+			"comment":     backup.Comment,
+			"start_time":  backup.StartTime,
+			"records":     records,
+		})
+	}
+
+	addDebug(action, backups)
+	if err := d.Set("backups", backups); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_backups", action, BCESDKGoERROR)
+	}
+	d.Set("total_count", len(backups))
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), backups); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_backups", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+Use this data source to query the engine versions which can be used to create a SCS instance.
+
+~> **NOTE:** SCS does not provide an api to list the engine versions, so the result is the redis versions supported by
+the provider rather than queried from the region. Memcache is not included since the instance of it can not be created
+by the provider.
+
+Example Usage
+
+```hcl
+data "baiducloud_scs_engine_versions" "default" {}
+
+resource "baiducloud_scs" "default" {
+  instance_name  = "terraform-redis"
+  engine_version = "${data.baiducloud_scs_engine_versions.default.latest}"
+  ...
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBaiduCloudScsEngineVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudScsEngineVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			// Attributes used for result
+			"engine_versions": {
+				Type:        schema.TypeList,
+				Description: "Engine versions in ascending order.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"engine": {
+							Type:        schema.TypeString,
+							Description: "Engine of the instance, such as redis.",
+							Computed:    true,
+						},
+						"engine_version": {
+							Type:        schema.TypeString,
+							Description: "Engine version of the instance, such as 3.2.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"latest": {
+				Type:        schema.TypeString,
+				Description: "The latest engine version, which can be used as engine_version of baiducloud_scs.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudScsEngineVersionsRead(d *schema.ResourceData, meta interface{}) error {
+	action := "Query SCS engine versions"
+
+	engineVersions := make([]map[string]interface{}, 0, len(ScsEngineVersions))
+	for _, version := range ScsEngineVersions {
+		engineVersions = append(engineVersions, map[string]interface{}{
+			"engine":         "redis",
+			"engine_version": version,
+		})
+	}
+	addDebug(action, engineVersions)
+
+	if err := d.Set("engine_versions", engineVersions); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_engine_versions", action, BCESDKGoERROR)
+	}
+	d.Set("latest", ScsEngineVersions[len(ScsEngineVersions)-1])
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), engineVersions); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_engine_versions", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
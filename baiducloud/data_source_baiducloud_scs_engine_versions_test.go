@@ -0,0 +1,36 @@
+package baiducloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccScsEngineVersionsDataSourceName = "data.baiducloud_scs_engine_versions.default"
+)
+
+func TestAccBaiduCloudScsEngineVersionsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsEngineVersionsDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsEngineVersionsDataSourceName),
+					resource.TestCheckResourceAttr(testAccScsEngineVersionsDataSourceName, "engine_versions.#", "2"),
+					resource.TestCheckResourceAttr(testAccScsEngineVersionsDataSourceName, "engine_versions.0.engine", "redis"),
+					resource.TestCheckResourceAttr(testAccScsEngineVersionsDataSourceName, "latest", "4.0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccScsEngineVersionsDataSourceConfig = `
+data "baiducloud_scs_engine_versions" "default" {}
+`
@@ -0,0 +1,72 @@
+/*
+Use this data source to query the status of a SCS instance. It only queries the instance detail once,
+which is lighter than baiducloud_scs for health checks.
+
+Example Usage
+
+```hcl
+data "baiducloud_scs_instance_status" "default" {
+  instance_id = "scs-bj-xxxxxxxx"
+}
+
+output "is_running" {
+  value = "${data.baiducloud_scs_instance_status.default.is_running}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudScsInstanceStatus() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudScsInstanceStatusRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Attributes used for result
+			"instance_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the instance.",
+				Computed:    true,
+			},
+			"is_running": {
+				Type:        schema.TypeBool,
+				Description: "Whether the status of the instance is Running.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudScsInstanceStatusRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	instanceID := d.Get("instance_id").(string)
+	action := "Query SCS Instance status " + instanceID
+
+	result, err := scsService.GetInstanceDetail(instanceID)
+	if err != nil {
+		if NotFoundError(err) {
+			return WrapErrorf(Error("SCS instance %s is not found", instanceID), DefaultErrorMsg, "baiducloud_scs_instance_status", action, BCESDKGoERROR)
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_instance_status", action, BCESDKGoERROR)
+	}
+
+	d.Set("instance_status", result.InstanceStatus)
+	d.Set("is_running", result.InstanceStatus == SCSStatusStatusRunning)
+	d.SetId(instanceID)
+
+	return nil
+}
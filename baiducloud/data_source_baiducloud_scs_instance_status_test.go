@@ -0,0 +1,60 @@
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccScsInstanceStatusDataSourceName = "data.baiducloud_scs_instance_status.default"
+)
+
+func TestAccBaiduCloudScsInstanceStatusDataSource(t *testing.T) {
+	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
+	name := BaiduCloudTestResourceTypeNameScs + "-" + timeStamp
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccScsDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsInstanceStatusDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsInstanceStatusDataSourceName),
+					resource.TestCheckResourceAttr(testAccScsInstanceStatusDataSourceName, "instance_status", SCSStatusStatusRunning),
+					resource.TestCheckResourceAttr(testAccScsInstanceStatusDataSourceName, "is_running", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScsInstanceStatusDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_scs" "default" {
+  instance_name   = "%s"
+  billing = {
+    payment_timing = "Postpaid"
+  }
+  purchase_count  = 1
+  port            = 6379
+  engine_version  = "3.2"
+  node_type       = "cache.n1.micro"
+  cluster_type    = "master_slave"
+  replication_num = 1
+  shard_num       = 1
+  proxy_num       = 0
+}
+
+data "baiducloud_scs_instance_status" "default" {
+  instance_id = baiducloud_scs.default.id
+}
+`, name)
+}
@@ -0,0 +1,263 @@
+/*
+Use this data source to query SCS instances, including the instances created outside terraform.
+
+Example Usage
+
+```hcl
+data "baiducloud_scs_instances" "default" {
+  name_regex      = "terraform-redis*"
+  instance_status = "Running"
+  tag_key         = "env"
+  tag_value       = "prod"
+  include_vpc_id  = true
+}
+
+output "instances" {
+  value = "${data.baiducloud_scs_instances.default.instances}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"regexp"
+
+	"github.com/baidubce/bce-sdk-go/services/scs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudScsInstances() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudScsInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Regex pattern of the search name of scs instance",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNameRegex,
+			},
+			"instance_status": {
+				Type:        schema.TypeString,
+				Description: "Status of the scs instance to search, such as Running, Paused.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"tag_key": {
+				Type:        schema.TypeString,
+				Description: "Tag key of the scs instances to search, only the instances bound with the tag are returned.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"tag_value": {
+				Type:        schema.TypeString,
+				Description: "Tag value of the scs instances to search, it requires tag_key. Any value of tag_key matches if it is not set.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"include_vpc_id": {
+				Type:        schema.TypeBool,
+				Description: "Whether to query the detail of every instance searched for its vpc_id, which the list api does not return. It makes one more request per instance, and the instances deleted meanwhile are skipped. Default to false.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file of the instances search result",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			"total_count": {
+				Type:        schema.TypeInt,
+				Description: "Total count of the instances searched.",
+				Computed:    true,
+			},
+			"instances": {
+				Type:        schema.TypeList,
+				Description: "The result of the instances list.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the instance.",
+							Computed:    true,
+						},
+						"instance_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the instance.",
+							Computed:    true,
+						},
+						"instance_status": {
+							Type:        schema.TypeString,
+							Description: "Status of the instance.",
+							Computed:    true,
+						},
+						"cluster_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the instance,  Available values are cluster, master_slave.",
+							Computed:    true,
+						},
+						"engine": {
+							Type:        schema.TypeString,
+							Description: "Engine of the instance. Available values are redis, memcache.",
+							Computed:    true,
+						},
+						"engine_version": {
+							Type:        schema.TypeString,
+							Description: "Engine version of the instance. Available values are 3.2, 4.0.",
+							Computed:    true,
+						},
+						"v_net_ip": {
+							Type:        schema.TypeString,
+							Description: "The internal ip used to access a instance.",
+							Computed:    true,
+						},
+						"domain": {
+							Type:        schema.TypeString,
+							Description: "Domain of the instance.",
+							Computed:    true,
+						},
+						"port": {
+							Type:        schema.TypeInt,
+							Description: "The port used to access a instance.",
+							Computed:    true,
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the VPC which the instance belongs to, it is only set if include_vpc_id is true.",
+							Computed:    true,
+						},
+						"create_time": {
+							Type:        schema.TypeString,
+							Description: "Create time of the instance.",
+							Computed:    true,
+						},
+						"capacity": {
+							Type:        schema.TypeInt,
+							Description: "Memory capacity(GB) of the instance.",
+							Computed:    true,
+						},
+						"used_capacity": {
+							Type:        schema.TypeInt,
+							Description: "Memory capacity(GB) of the instance to be used.",
+							Computed:    true,
+						},
+						"payment_timing": {
+							Type:        schema.TypeString,
+							Description: "SCS payment timing",
+							Computed:    true,
+						},
+						"zone_names": {
+							Type:        schema.TypeList,
+							Description: "Zone name list",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"tags": tagsComputedSchema(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudScsInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	action := "List all scs instances"
+	instanceList, err := scsService.ListAllInstances(&scs.ListInstancesArgs{})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_instances", action, BCESDKGoERROR)
+	}
+
+	var nameRegex *regexp.Regexp
+	if value, ok := d.GetOk("name_regex"); ok && len(value.(string)) > 0 {
+		nameRegex = regexp.MustCompile(value.(string))
+	}
+
+	var instanceStatus string
+	if value, ok := d.GetOk("instance_status"); ok {
+		instanceStatus = value.(string)
+	}
+
+	tagKey, filterTag := d.GetOk("tag_key")
+	tagValue, filterTagValue := d.GetOk("tag_value")
+	if filterTagValue && !filterTag {
+		return WrapErrorf(Error("tag_value requires tag_key"), DefaultErrorMsg, "baiducloud_scs_instances", action, BCESDKGoERROR)
+	}
+
+	matched := make([]scs.InstanceModel, 0, len(instanceList))
+	for _, inst := range instanceList {
+		if nameRegex != nil && !nameRegex.MatchString(inst.InstanceName) {
+			continue
+		}
+		if instanceStatus != "" && inst.InstanceStatus != instanceStatus {
+			continue
+		}
+		if filterTag {
+			value, ok := flattenTagsToMap(client, inst.Tags)[tagKey.(string)]
+			if !ok || (filterTagValue && value != tagValue.(string)) {
+				continue
+			}
+		}
+		matched = append(matched, inst)
+	}
+
+	instanceMap := make([]map[string]interface{}, 0, len(matched))
+	filter := NewDataSourceFilter(d)
+	for _, inst := range scsService.FlattenScsModelsToMap(matched) {
+		if filter.checkFilter(inst) {
+			instanceMap = append(instanceMap, inst)
+		}
+	}
+
+	if d.Get("include_vpc_id").(bool) {
+		if instanceMap, err = setScsInstancesVpcID(scsService, instanceMap); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_instances", action, BCESDKGoERROR)
+		}
+	}
+
+	addDebug("List filtered scs instances", instanceMap)
+	if err := d.Set("instances", instanceMap); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_instances", action, BCESDKGoERROR)
+	}
+	d.Set("total_count", len(instanceMap))
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), instanceMap); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_instances", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
+
+// setScsInstancesVpcID sets the vpc_id of the instances from their details, since the list api does not return it.
+// The instances which are deleted after being listed are skipped.
+func setScsInstancesVpcID(scsService ScsService, instances []map[string]interface{}) ([]map[string]interface{}, error) {
+	result := make([]map[string]interface{}, 0, len(instances))
+	for _, inst := range instances {
+		detail, err := scsService.GetInstanceDetail(inst["instance_id"].(string))
+		if err != nil {
+			if NotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		inst["vpc_id"] = detail.VpcID
+		result = append(result, inst)
+	}
+	return result, nil
+}
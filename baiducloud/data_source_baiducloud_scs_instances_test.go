@@ -0,0 +1,100 @@
+package baiducloud
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccScsInstancesDataSourceName          = "data.baiducloud_scs_instances.default"
+	testAccScsInstancesDataSourceAttrKeyPrefix = "instances.0."
+)
+
+func TestAccBaiduCloudScsInstancesDataSource(t *testing.T) {
+	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
+	name := BaiduCloudTestResourceTypeNameScs + "-" + timeStamp
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsInstancesDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsInstancesDataSourceName),
+					resource.TestCheckResourceAttr(testAccScsInstancesDataSourceName, "total_count", "1"),
+					resource.TestCheckResourceAttr(testAccScsInstancesDataSourceName, testAccScsInstancesDataSourceAttrKeyPrefix+"instance_name", name),
+					resource.TestCheckResourceAttr(testAccScsInstancesDataSourceName, testAccScsInstancesDataSourceAttrKeyPrefix+"instance_status", "Running"),
+					resource.TestCheckResourceAttrSet(testAccScsInstancesDataSourceName, testAccScsInstancesDataSourceAttrKeyPrefix+"instance_id"),
+					resource.TestCheckResourceAttrSet(testAccScsInstancesDataSourceName, testAccScsInstancesDataSourceAttrKeyPrefix+"domain"),
+					resource.TestCheckResourceAttrSet(testAccScsInstancesDataSourceName, testAccScsInstancesDataSourceAttrKeyPrefix+"port"),
+					resource.TestCheckResourceAttrSet(testAccScsInstancesDataSourceName, testAccScsInstancesDataSourceAttrKeyPrefix+"vpc_id"),
+					resource.TestCheckResourceAttr(testAccScsInstancesDataSourceName, testAccScsInstancesDataSourceAttrKeyPrefix+"tags.testKey", "testValue"),
+				),
+			},
+		},
+	})
+}
+
+func TestSetScsInstancesVpcID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if strings.HasSuffix(r.URL.Path, "/scs-bj-deleted") {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":"InstanceNotExist","message":"instance not exist","requestId":"req"}`))
+			return
+		}
+		w.Write([]byte(`{"instanceId":"scs-bj-running","vpcId":"vpc-test"}`))
+	}))
+	defer server.Close()
+
+	// the instance deleted after being listed is skipped instead of failing the data source
+	instances, err := setScsInstancesVpcID(newScsTestService(t, server.URL, 0), []map[string]interface{}{
+		{"instance_id": "scs-bj-running"},
+		{"instance_id": "scs-bj-deleted"},
+	})
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(instances) != 1 || instances[0]["instance_id"] != "scs-bj-running" || instances[0]["vpc_id"] != "vpc-test" {
+		t.Fatalf("expected only the running instance with its vpc_id, got %v", instances)
+	}
+}
+
+func testAccScsInstancesDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_scs" "default" {
+    instance_name           = "%s"
+    billing = {
+   		payment_timing 		= "Postpaid"
+    }
+    purchase_count 			= 1
+ 	port 					= 6379
+	engine_version 			= "3.2"
+	node_type 				= "cache.n1.micro"
+	cluster_type 			= "master_slave"
+	replication_num 		= 1
+	shard_num 				= 1
+	proxy_num 				= 0
+	tags = {
+		"testKey" = "testValue"
+	}
+}
+
+data "baiducloud_scs_instances" "default" {
+    name_regex        = baiducloud_scs.default.instance_name
+    instance_status   = "Running"
+    tag_key           = keys(baiducloud_scs.default.tags)[0]
+    tag_value         = "testValue"
+    include_vpc_id    = true
+}
+`, name)
+}
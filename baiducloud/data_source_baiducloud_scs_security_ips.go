@@ -0,0 +1,127 @@
+/*
+Use this data source to query the IP whitelist and the security groups of a SCS instance.
+
+Example Usage
+
+```hcl
+data "baiducloud_scs_security_ips" "default" {
+  instance_id = "scs-bj-xxxxxxxx"
+  output_file = "scs_security_ips.json"
+}
+
+output "security_ips" {
+  value = "${data.baiducloud_scs_security_ips.default.security_ips}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudScsSecurityIps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudScsSecurityIpsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the SCS instance.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			// Attributes used for result
+			"security_ips": {
+				Type:        schema.TypeList,
+				Description: "IP whitelist of the instance, such as 192.168.1.1 or 192.168.1.0/24.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"security_groups": {
+				Type:        schema.TypeList,
+				Description: "Security groups bound to the instance.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"security_group_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the security group.",
+							Computed:    true,
+						},
+						"security_group_name": {
+							Type:        schema.TypeString,
+							Description: "Name of the security group.",
+							Computed:    true,
+						},
+						"vpc_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the VPC of the security group.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudScsSecurityIpsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	instanceID := d.Get("instance_id").(string)
+	action := "Query SCS security ips " + instanceID
+
+	ipResult, err := scsService.GetSecurityIp(instanceID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ips", action, BCESDKGoERROR)
+	}
+	groupResult, err := scsService.ListSecurityGroupByInstanceId(instanceID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ips", action, BCESDKGoERROR)
+	}
+
+	securityIps := make([]string, 0, len(ipResult.SecurityIps))
+	securityIps = append(securityIps, ipResult.SecurityIps...)
+
+	securityGroups := make([]map[string]interface{}, 0, len(groupResult.Groups))
+	for _, group := range groupResult.Groups {
+		securityGroups = append(securityGroups, map[string]interface{}{
+			"security_group_id":   group.SecurityGroupID,
+			"security_group_name": group.SecurityGroupName,
+			"vpc_id":              group.VpcID,
+		})
+	}
+
+	if err := d.Set("security_ips", securityIps); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ips", action, BCESDKGoERROR)
+	}
+	if err := d.Set("security_groups", securityGroups); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ips", action, BCESDKGoERROR)
+	}
+	d.SetId(instanceID)
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), map[string]interface{}{
+			"instance_id":     instanceID,
+			"security_ips":    securityIps,
+			"security_groups": securityGroups,
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ips", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
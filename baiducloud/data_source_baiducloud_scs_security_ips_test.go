@@ -0,0 +1,65 @@
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccScsSecurityIpsDataSourceName = "data.baiducloud_scs_security_ips.default"
+)
+
+func TestAccBaiduCloudScsSecurityIpsDataSource(t *testing.T) {
+	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
+	name := BaiduCloudTestResourceTypeNameScs + "-" + timeStamp
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccScsDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsSecurityIpsDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsSecurityIpsDataSourceName),
+					resource.TestCheckResourceAttr(testAccScsSecurityIpsDataSourceName, "security_ips.#", "1"),
+					resource.TestCheckResourceAttr(testAccScsSecurityIpsDataSourceName, "security_ips.0", "192.168.1.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScsSecurityIpsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_scs" "default" {
+  instance_name   = "%s"
+  billing = {
+    payment_timing = "Postpaid"
+  }
+  purchase_count  = 1
+  port            = 6379
+  engine_version  = "3.2"
+  node_type       = "cache.n1.micro"
+  cluster_type    = "master_slave"
+  replication_num = 1
+  shard_num       = 1
+  proxy_num       = 0
+}
+
+resource "baiducloud_scs_security_ip" "default" {
+  instance_id  = baiducloud_scs.default.id
+  security_ips = ["192.168.1.0/24"]
+}
+
+data "baiducloud_scs_security_ips" "default" {
+  instance_id = baiducloud_scs_security_ip.default.id
+}
+`, name)
+}
@@ -0,0 +1,183 @@
+/*
+Use this data source to query the slow log files of a SCS instance. The slow log is archived into files per shard,
+the entries can be fetched from the download url of each file.
+
+Example Usage
+
+```hcl
+data "baiducloud_scs_slowlog" "default" {
+  instance_id = "scs-bj-xxxxxxxx"
+  start_time  = "2021-08-10T00:00:00Z"
+  end_time    = "2021-08-11T00:00:00Z"
+}
+
+output "slowlogs" {
+  value = "${data.baiducloud_scs_slowlog.default.slowlogs}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/scs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+const scsSlowlogFileType = "slowlog"
+
+func dataSourceBaiduCloudScsSlowlog() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudScsSlowlogRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the SCS instance.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"start_time": {
+				Type:         schema.TypeString,
+				Description:  "Start time of the slow log to search, in UTC format such as 2021-08-10T00:00:00Z. Default to 24 hours before end_time.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"end_time": {
+				Type:         schema.TypeString,
+				Description:  "End time of the slow log to search, in UTC format such as 2021-08-11T00:00:00Z. Default to the current time.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file of the slow log search result",
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			"total_count": {
+				Type:        schema.TypeInt,
+				Description: "Total count of the slow log files searched.",
+				Computed:    true,
+			},
+			"slowlogs": {
+				Type:        schema.TypeList,
+				Description: "The result of the slow log files list.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"shard_id": {
+							Type:        schema.TypeInt,
+							Description: "ID of the shard which the log belongs to.",
+							Computed:    true,
+						},
+						"shard_show_id": {
+							Type:        schema.TypeString,
+							Description: "Display ID of the shard which the log belongs to.",
+							Computed:    true,
+						},
+						"log_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the log file.",
+							Computed:    true,
+						},
+						"log_start_time": {
+							Type:        schema.TypeString,
+							Description: "Start time of the log file.",
+							Computed:    true,
+						},
+						"log_end_time": {
+							Type:        schema.TypeString,
+							Description: "End time of the log file.",
+							Computed:    true,
+						},
+						"log_size_in_bytes": {
+							Type:        schema.TypeInt,
+							Description: "Size of the log file in bytes.",
+							Computed:    true,
+						},
+						"download_url": {
+							Type:        schema.TypeString,
+							Description: "Url to download the log file.",
+							Computed:    true,
+						},
+						"download_expires": {
+							Type:        schema.TypeString,
+							Description: "Expire time of the download url.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudScsSlowlogRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	instanceID := d.Get("instance_id").(string)
+	action := "List SCS slow logs " + instanceID
+
+	endTime := time.Now().UTC()
+	if v, ok := d.GetOk("end_time"); ok {
+		endTime, _ = time.Parse(time.RFC3339, v.(string))
+	}
+	startTime := endTime.Add(-24 * time.Hour)
+	if v, ok := d.GetOk("start_time"); ok {
+		startTime, _ = time.Parse(time.RFC3339, v.(string))
+	}
+
+	args := &scs.ListLogArgs{
+		FileType:  scsSlowlogFileType,
+		StartTime: startTime.UTC().Format("2006-01-02T15:04:05Z"),
+		EndTime:   endTime.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+	raw, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+		return scsClient.ListLogByInstanceId(instanceID, args)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_slowlog", action, BCESDKGoERROR)
+	}
+	result, _ := raw.(*scs.ListLogResult)
+
+	// only the metadata of the log files is kept, the entries are not loaded into memory
+	slowlogs := make([]map[string]interface{}, 0)
+	for _, shard := range result.LogList {
+		for _, item := range shard.LogItem {
+			slowlogs = append(slowlogs, map[string]interface{}{
+				"shard_id":          shard.ShardID,
+				"shard_show_id":     shard.ShardShowID,
+				"log_id":            item.LogID,
+				"log_start_time":    item.LogStartTime,
+				"log_end_time":      item.LogEndTime,
+				"log_size_in_bytes": item.LogSizeInBytes,
+				"download_url":      item.DownloadURL,
+				"download_expires":  item.DownloadExpires,
+			})
+		}
+	}
+
+	if err := d.Set("slowlogs", slowlogs); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_slowlog", action, BCESDKGoERROR)
+	}
+	d.Set("total_count", len(slowlogs))
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), slowlogs); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_slowlog", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
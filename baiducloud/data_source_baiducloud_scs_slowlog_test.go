@@ -0,0 +1,57 @@
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccScsSlowlogDataSourceName = "data.baiducloud_scs_slowlog.default"
+)
+
+func TestAccBaiduCloudScsSlowlogDataSource(t *testing.T) {
+	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
+	name := BaiduCloudTestResourceTypeNameScs + "-" + timeStamp
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsSlowlogDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsSlowlogDataSourceName),
+					resource.TestCheckResourceAttrSet(testAccScsSlowlogDataSourceName, "total_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScsSlowlogDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_scs" "default" {
+    instance_name           = "%s"
+    billing = {
+   		payment_timing 		= "Postpaid"
+    }
+    purchase_count 			= 1
+ 	port 					= 6379
+	engine_version 			= "3.2"
+	node_type 				= "cache.n1.micro"
+	cluster_type 			= "master_slave"
+	replication_num 		= 1
+	shard_num 				= 1
+	proxy_num 				= 0
+}
+
+data "baiducloud_scs_slowlog" "default" {
+    instance_id = baiducloud_scs.default.id
+}
+`, name)
+}
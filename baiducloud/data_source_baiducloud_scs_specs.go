@@ -29,15 +29,15 @@ func dataSourceBaiduCloudScsSpecs() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			"cluster_type": {
 				Type:         schema.TypeString,
-				Description:  "Type of the instance,  Available values are cluster, master_slave.",
-				Required:     true,
+				Description:  "Type of the instance,  Available values are cluster, master_slave. If not set, specs of both types are returned.",
+				Optional:     true,
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"cluster", "master_slave"}, false),
 			},
 			"node_capacity": {
 				Type:        schema.TypeInt,
-				Description: "Memory capacity(GB) of the instance node.",
-				Required:    true,
+				Description: "Memory capacity(GB) of the instance node. If not set, specs of all capacities are returned.",
+				Optional:    true,
 				ForceNew:    true,
 			},
 			"output_file": {
@@ -65,6 +65,39 @@ func dataSourceBaiduCloudScsSpecs() *schema.Resource {
 							Description: "Useful node type",
 							Computed:    true,
 						},
+						"cluster_type": {
+							Type:        schema.TypeString,
+							Description: "Type of the instance which the node type is supported by, cluster or master_slave.",
+							Computed:    true,
+						},
+						"cpu_num": {
+							Type:        schema.TypeInt,
+							Description: "CPU number of the instance node.",
+							Computed:    true,
+						},
+						"network_throughput_in_gbps": {
+							Type:        schema.TypeFloat,
+							Description: "Network throughput(Gbps) of the instance node.",
+							Computed:    true,
+						},
+						"peak_qps": {
+							Type:        schema.TypeInt,
+							Description: "Peak QPS of the instance node.",
+							Computed:    true,
+						},
+						"max_connections": {
+							Type:        schema.TypeInt,
+							Description: "Max connections of the instance node.",
+							Computed:    true,
+						},
+						"allowed_node_num_list": {
+							Type:        schema.TypeList,
+							Description: "Allowed shard numbers of the node type.",
+							Computed:    true,
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
 					},
 				},
 			},
@@ -89,12 +122,10 @@ func dataSourceBaiduCloudScsSpecsRead(d *schema.ResourceData, meta interface{})
 		clusterType = value.(string)
 	}
 
-	var nodeTypeList []scs.NodeType
 	getNodeTypeListResult := raw.(*scs.GetNodeTypeListResult)
-	if len(clusterType) > 0 && clusterType == "cluster" {
-		nodeTypeList = getNodeTypeListResult.ClusterNodeTypeList
-	} else if clusterType == "master_slave" {
-		nodeTypeList = getNodeTypeListResult.DefaultNodeTypeList
+	nodeTypeLists := map[string][]scs.NodeType{
+		"cluster":      getNodeTypeListResult.ClusterNodeTypeList,
+		"master_slave": getNodeTypeListResult.DefaultNodeTypeList,
 	}
 
 	var nodeCapacity int
@@ -102,21 +133,32 @@ func dataSourceBaiduCloudScsSpecsRead(d *schema.ResourceData, meta interface{})
 		nodeCapacity = value.(int)
 	}
 
-	specMapOrigin := make([]map[string]interface{}, 0, len(nodeTypeList))
-	for _, spec := range nodeTypeList {
-
-		if nodeCapacity > 0 && spec.InstanceFlavor != nodeCapacity {
+	specMapOrigin := make([]map[string]interface{}, 0)
+	for _, t := range []string{"cluster", "master_slave"} {
+		if len(clusterType) > 0 && clusterType != t {
 			continue
 		}
 
-		specMapOrigin = append(specMapOrigin, map[string]interface{}{
-			"node_capacity": spec.InstanceFlavor,
-			"node_type":     spec.NodeType,
-		})
+		for _, spec := range nodeTypeLists[t] {
+			if nodeCapacity > 0 && spec.InstanceFlavor != nodeCapacity {
+				continue
+			}
+
+			specMapOrigin = append(specMapOrigin, map[string]interface{}{
+				"node_capacity":              spec.InstanceFlavor,
+				"node_type":                  spec.NodeType,
+				"cluster_type":               t,
+				"cpu_num":                    spec.CPUNum,
+				"network_throughput_in_gbps": spec.NetworkThroughputInGbps,
+				"peak_qps":                   spec.PeakQPS,
+				"max_connections":            spec.MaxConnections,
+				"allowed_node_num_list":      spec.AllowedNodeNumList,
+			})
+		}
 	}
 
 	//FilterDataSourceResult(d, &specMap)
-	specMap := make([]map[string]interface{}, 0, len(nodeTypeList))
+	specMap := make([]map[string]interface{}, 0, len(specMapOrigin))
 	filter := NewDataSourceFilter(d)
 	for _, data := range specMapOrigin {
 		if filter.checkFilter(data) {
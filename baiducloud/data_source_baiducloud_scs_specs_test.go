@@ -24,6 +24,9 @@ func TestAccBaiduCloudScsSpecsDataSource(t *testing.T) {
 					testAccCheckBaiduCloudDataSourceId(testAccScsSpecsDataSourceName),
 					resource.TestCheckResourceAttr(testAccScsSpecsDataSourceName, testAccScsSpecsDataSourceAttrKeyPrefix+"node_capacity", "1"),
 					resource.TestCheckResourceAttr(testAccScsSpecsDataSourceName, testAccScsSpecsDataSourceAttrKeyPrefix+"node_type", "cache.n1.micro"),
+					resource.TestCheckResourceAttr(testAccScsSpecsDataSourceName, testAccScsSpecsDataSourceAttrKeyPrefix+"cluster_type", "cluster"),
+					resource.TestCheckResourceAttrSet(testAccScsSpecsDataSourceName, testAccScsSpecsDataSourceAttrKeyPrefix+"cpu_num"),
+					resource.TestCheckResourceAttrSet(testAccScsSpecsDataSourceName, testAccScsSpecsDataSourceAttrKeyPrefix+"allowed_node_num_list.#"),
 				),
 			},
 		},
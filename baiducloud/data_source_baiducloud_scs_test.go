@@ -0,0 +1,113 @@
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/model"
+	"github.com/baidubce/bce-sdk-go/services/scs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	testAccScsDataSourceName = "data.baiducloud_scs.default"
+)
+
+func TestAccBaiduCloudScsDataSource(t *testing.T) {
+	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
+	name := BaiduCloudTestResourceTypeNameScs + "-" + timeStamp
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsDataSourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsDataSourceName),
+					resource.TestCheckResourceAttr(testAccScsDataSourceName, "instance_name", name),
+					resource.TestCheckResourceAttr(testAccScsDataSourceName, "instance_status", "Running"),
+					resource.TestCheckResourceAttr(testAccScsDataSourceName, "port", "6379"),
+					resource.TestCheckResourceAttr(testAccScsDataSourceName, "engine_version", "3.2"),
+					resource.TestCheckResourceAttrSet(testAccScsDataSourceName, "domain"),
+					resource.TestCheckResourceAttrSet(testAccScsDataSourceName, "connection_string"),
+					resource.TestCheckResourceAttrSet(testAccScsDataSourceName, "v_net_ip"),
+					resource.TestCheckResourceAttrSet(testAccScsDataSourceName, "capacity"),
+				),
+			},
+		},
+	})
+}
+
+func TestFlattenScsInstanceDetail(t *testing.T) {
+	client := newIgnoreTagsClient(t, nil, nil)
+	result := &scs.GetInstanceDetailResult{
+		InstanceID:     "scs-bj-test",
+		InstanceName:   "redis-test",
+		InstanceStatus: "Running",
+		ClusterType:    "master_slave",
+		Domain:         "redis.example.com",
+		Port:           6379,
+		Capacity:       4,
+		UsedCapacity:   1,
+		PaymentTiming:  "Prepaid",
+		AutoRenew:      "true",
+		VpcID:          "vpc-test",
+		Subnets:        []scs.Subnet{{SubnetID: "sbn-a", ZoneName: "cn-bj-a"}},
+		ZoneNames:      []string{"cn-bj-a"},
+		Tags:           []model.TagModel{{TagKey: "env", TagValue: "test"}},
+	}
+
+	// every attribute must be accepted by the schema, the sdk returns some of them in other types
+	d := schema.TestResourceDataRaw(t, dataSourceBaiduCloudScs().Schema, map[string]interface{}{"instance_id": result.InstanceID})
+	for key, value := range flattenScsInstanceDetail(client, result) {
+		if err := d.Set(key, value); err != nil {
+			t.Fatalf("failed to set %s: %v", key, err)
+		}
+	}
+
+	expected := map[string]string{
+		"auto_renew":          "true",
+		"connection_string":   "redis.example.com:6379",
+		"memory_usage_ratio":  "0.25",
+		"subnets.0.subnet_id": "sbn-a",
+		"tags.env":            "test",
+	}
+	for key, value := range expected {
+		if got := d.Get(key); fmt.Sprint(got) != value {
+			t.Fatalf("expected %s to be %s, got %v", key, value, got)
+		}
+	}
+
+	result.AutoRenew = "false"
+	if flattenScsInstanceDetail(client, result)["auto_renew"] != false {
+		t.Fatalf("expected auto_renew to be false")
+	}
+}
+
+func testAccScsDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_scs" "default" {
+    instance_name           = "%s"
+    billing = {
+   		payment_timing 		= "Postpaid"
+    }
+    purchase_count 			= 1
+ 	port 					= 6379
+	engine_version 			= "3.2"
+	node_type 				= "cache.n1.micro"
+	cluster_type 			= "master_slave"
+	replication_num 		= 1
+	shard_num 				= 1
+	proxy_num 				= 0
+}
+
+data "baiducloud_scs" "default" {
+    instance_id = baiducloud_scs.default.id
+}
+`, name)
+}
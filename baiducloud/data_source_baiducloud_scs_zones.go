@@ -0,0 +1,113 @@
+/*
+Use this data source to query the zones where SCS is available in the current region.
+
+Example Usage
+
+```hcl
+data "baiducloud_scs_zones" "default" {}
+
+output "zones" {
+  value = "${data.baiducloud_scs_zones.default.zones}"
+}
+```
+*/
+package baiducloud
+
+import (
+	"regexp"
+
+	"github.com/baidubce/bce-sdk-go/services/scs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func dataSourceBaiduCloudScsZones() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBaiduCloudScsZonesRead,
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Description:  "Regex pattern of the search zone name",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validateNameRegex,
+			},
+			"output_file": {
+				Type:        schema.TypeString,
+				Description: "Output file for saving result.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"filter": dataSourceFiltersSchema(),
+
+			// Attributes used for result
+			"zones": {
+				Type:        schema.TypeList,
+				Description: "Zone list where SCS is available",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone_name": {
+							Type:        schema.TypeString,
+							Description: "Zone name, which can be used as zone_name of the subnets of baiducloud_scs",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBaiduCloudScsZonesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	action := "Query all SCS zones"
+	raw, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+		return scsClient.GetZoneList()
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_zones", action, BCESDKGoERROR)
+	}
+	addDebug(action, raw)
+
+	var zoneNameRegex *regexp.Regexp
+	if value, ok := d.GetOk("name_regex"); ok && value.(string) != "" {
+		zoneNameRegex = regexp.MustCompile(value.(string))
+	}
+
+	response := raw.(*scs.GetZoneListResult)
+	zoneMap := make([]map[string]interface{}, 0)
+	seen := make(map[string]bool)
+	for _, zones := range response.Zones {
+		for _, zoneName := range zones.ZoneNames {
+			if seen[zoneName] {
+				continue
+			}
+			seen[zoneName] = true
+			if zoneNameRegex != nil && !zoneNameRegex.MatchString(zoneName) {
+				continue
+			}
+			zoneMap = append(zoneMap, map[string]interface{}{
+				"zone_name": zoneName,
+			})
+		}
+	}
+
+	FilterDataSourceResult(d, &zoneMap)
+	if err := d.Set("zones", zoneMap); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_zones", action, BCESDKGoERROR)
+	}
+	d.SetId(resource.UniqueId())
+
+	if v, ok := d.GetOk("output_file"); ok && v.(string) != "" {
+		if err := writeToFile(v.(string), zoneMap); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_zones", action, BCESDKGoERROR)
+		}
+	}
+
+	return nil
+}
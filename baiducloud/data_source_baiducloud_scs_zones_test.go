@@ -0,0 +1,36 @@
+package baiducloud
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccScsZonesDataSourceName          = "data.baiducloud_scs_zones.default"
+	testAccScsZonesDataSourceAttrKeyPrefix = "zones.0."
+)
+
+func TestAccBaiduCloudScsZonesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsZonesDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsZonesDataSourceName),
+					resource.TestCheckResourceAttrSet(testAccScsZonesDataSourceName, testAccScsZonesDataSourceAttrKeyPrefix+"zone_name"),
+				),
+			},
+		},
+	})
+}
+
+const testAccScsZonesDataSourceConfig = `
+data "baiducloud_scs_zones" "default" {
+  name_regex = "^zone"
+}
+`
@@ -203,7 +203,7 @@ func dataSourceBaiduCloudScssRead(d *schema.ResourceData, meta interface{}) erro
 			"used_capacity":   e.UsedCapacity,
 			"payment_timing":  e.PaymentTiming,
 			"zone_names":      e.ZoneNames,
-			"tags":            flattenTagsToMap(e.Tags),
+			"tags":            flattenTagsToMap(client, e.Tags),
 		})
 	}
 
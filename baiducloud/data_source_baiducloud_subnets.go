@@ -171,7 +171,7 @@ func dataSourceBaiduCloudSubnetsRead(d *schema.ResourceData, meta interface{}) e
 		subnetMap["subnet_type"] = subnet.SubnetType
 		subnetMap["description"] = subnet.Description
 		subnetMap["available_ip"] = subnet.AvailableIp
-		subnetMap["tags"] = flattenTagsToMap(subnet.Tags)
+		subnetMap["tags"] = flattenTagsToMap(client, subnet.Tags)
 
 		if !filter.checkFilter(subnetMap) {
 			continue
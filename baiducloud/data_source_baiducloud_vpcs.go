@@ -8,6 +8,10 @@ data "baiducloud_vpcs" "default" {
     name="test-vpc"
 }
 
+data "baiducloud_vpcs" "by_cidr" {
+    cidr = "192.168.1.0/24"
+}
+
 output "cidr" {
   value = "${data.baiducloud_vpcs.default.vpcs.0.cidr}"
 }
@@ -18,6 +22,7 @@ package baiducloud
 import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
@@ -39,6 +44,13 @@ func dataSourceBaiduCloudVpcs() *schema.Resource {
 				Optional:    true,
 				ForceNew:    true,
 			},
+			"cidr": {
+				Type:         schema.TypeString,
+				Description:  "CIDR block to retrieve the VPCs by, the VPCs whose cidr or secondary_cidrs overlap with it are returned. Several VPCs may be returned since the cidr blocks of VPCs can overlap.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.CIDRNetwork(0, 32),
+			},
 			"output_file": {
 				Type:        schema.TypeString,
 				Description: "Output file for saving result.",
@@ -107,6 +119,7 @@ func dataSourceBaiduCloudVpcsRead(d *schema.ResourceData, meta interface{}) erro
 	var (
 		vpcId      string
 		name       string
+		cidr       string
 		outputFile string
 	)
 	if v := d.Get("vpc_id").(string); v != "" {
@@ -115,6 +128,9 @@ func dataSourceBaiduCloudVpcsRead(d *schema.ResourceData, meta interface{}) erro
 	if v := d.Get("name").(string); v != "" {
 		name = v
 	}
+	if v := d.Get("cidr").(string); v != "" {
+		cidr = v
+	}
 	if v := d.Get("output_file").(string); v != "" {
 		outputFile = v
 	}
@@ -132,6 +148,9 @@ func dataSourceBaiduCloudVpcsRead(d *schema.ResourceData, meta interface{}) erro
 			(name != "" && name != vpc.Name) {
 			continue
 		}
+		if cidr != "" && !vpcCidrOverlaps(vpc.Cidr, vpc.SecondaryCidr, cidr) {
+			continue
+		}
 
 		vpcMap := make(map[string]interface{})
 		vpcMap["vpc_id"] = vpc.VPCID
@@ -140,7 +159,7 @@ func dataSourceBaiduCloudVpcsRead(d *schema.ResourceData, meta interface{}) erro
 		vpcMap["cidr"] = vpc.Cidr
 		vpcMap["description"] = vpc.Description
 		vpcMap["secondary_cidrs"] = vpc.SecondaryCidr
-		vpcMap["tags"] = flattenTagsToMap(vpc.Tags)
+		vpcMap["tags"] = flattenTagsToMap(client, vpc.Tags)
 
 		res, err := vpcService.GetRouteTableDetail("", vpc.VPCID)
 		if err != nil {
@@ -167,3 +186,15 @@ func dataSourceBaiduCloudVpcsRead(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+func vpcCidrOverlaps(primaryCidr string, secondaryCidrs []string, cidr string) bool {
+	if cidrOverlaps(primaryCidr, cidr) {
+		return true
+	}
+	for _, secondaryCidr := range secondaryCidrs {
+		if cidrOverlaps(secondaryCidr, cidr) {
+			return true
+		}
+	}
+	return false
+}
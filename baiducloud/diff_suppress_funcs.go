@@ -1,6 +1,7 @@
 package baiducloud
 
 import (
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform/helper/schema"
@@ -10,6 +11,30 @@ func postPaidDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return d.Get("payment_timing").(string) == PaymentTimingPostpaid
 }
 
+func scsAutoRenewDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("billing.payment_timing").(string) != PaymentTimingPrepai || !d.Get("auto_renew").(bool)
+}
+
+func scsCapacityDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	// the instance already provides the capacity if it is not less than the expected one
+	oldCapacity, _ := strconv.Atoi(old)
+	newCapacity, _ := strconv.Atoi(new)
+	return old != "" && newCapacity <= oldCapacity
+}
+
+func scsClientTokenDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	// the token is cleared from the state once the instance is created and is not used any more
+	return d.Id() != ""
+}
+
+func scsZoneNameDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return old != "" && scsZoneNameEqual(old, new)
+}
+
+func trimSpaceDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
 func appServerGroupPortHealthCheckHTTPSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	strs := strings.Split(k, ".")
 	if len(strs) == 3 {
@@ -3,6 +3,7 @@ package baiducloud
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"runtime"
 	"strings"
 
@@ -170,6 +171,77 @@ func IsExceptedErrors(err error, expectCodes []string) bool {
 	return false
 }
 
+// ScsRetryableErrorCodes are SCS service error codes which are transient and worth retrying
+var ScsRetryableErrorCodes = []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}
+
+// IsRetryableScsError classifies an error returned by the SCS service by its bce.BceServiceError
+// Code and StatusCode instead of matching the error message.
+// Errors with a code in ScsRetryableErrorCodes or extraCodes, throttled (429) and server side (5xx)
+// errors are retryable; not found (404) and other client side errors are fatal.
+// Client errors raised before a response is received are also treated as fatal.
+func IsRetryableScsError(err error, extraCodes ...string) bool {
+	if e, ok := err.(*WrapErrorOld); ok {
+		err = e.originError
+	}
+	if err == nil {
+		return false
+	}
+	if e, ok := err.(*ComplexError); ok {
+		return IsRetryableScsError(e.Cause, extraCodes...)
+	}
+
+	e, ok := err.(*bce.BceServiceError)
+	if !ok {
+		return false
+	}
+	if stringInSlice(ScsRetryableErrorCodes, e.Code) || stringInSlice(extraCodes, e.Code) {
+		return true
+	}
+	if e.StatusCode == http.StatusNotFound || stringInSlice(NotFoundErrorList, e.Code) {
+		return false
+	}
+	return e.StatusCode == http.StatusTooManyRequests || e.StatusCode >= http.StatusInternalServerError
+}
+
+// IsScsBackoffError reports whether the error is an internal error or a throttling of the SCS service,
+// which is retried with an exponential backoff instead of a fixed interval
+func IsScsBackoffError(err error) bool {
+	if e, ok := err.(*WrapErrorOld); ok {
+		err = e.originError
+	}
+	if e, ok := err.(*ComplexError); ok {
+		return IsScsBackoffError(e.Cause)
+	}
+
+	e, ok := err.(*bce.BceServiceError)
+	if !ok {
+		return false
+	}
+	return e.Code == bce.EINTERNAL_ERROR || e.StatusCode == http.StatusTooManyRequests
+}
+
+// IsRequestTimeoutError reports whether the error is a request which is abandoned by the sdk client because it
+// does not complete within the connection timeout
+func IsRequestTimeoutError(err error) bool {
+	if e, ok := err.(*WrapErrorOld); ok {
+		err = e.originError
+	}
+	if e, ok := err.(*ComplexError); ok {
+		return IsRequestTimeoutError(e.Cause)
+	}
+
+	e, ok := err.(*bce.BceClientError)
+	if !ok {
+		return false
+	}
+	for _, msg := range []string{"Client.Timeout exceeded", "deadline exceeded", "i/o timeout"} {
+		if strings.Contains(e.Error(), msg) {
+			return true
+		}
+	}
+	return false
+}
+
 func (e ComplexError) Error() string {
 	if e.Cause == nil {
 		e.Cause = Error("<nil cause>")
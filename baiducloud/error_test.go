@@ -0,0 +1,79 @@
+package baiducloud
+
+import (
+	"testing"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+)
+
+func TestIsRetryableScsError(t *testing.T) {
+	cases := []struct {
+		name       string
+		err        error
+		extraCodes []string
+		expected   bool
+	}{
+		{"nil", nil, nil, false},
+		{"internal error", bce.NewBceServiceError(bce.EINTERNAL_ERROR, "internal error", "req", 500), nil, true},
+		{"invalid instance status", bce.NewBceServiceError(InvalidInstanceStatus, "instance is busy", "req", 409), nil, true},
+		{"operation exception", bce.NewBceServiceError(OperationException, "try again", "req", 400), nil, true},
+		{"not found", bce.NewBceServiceError(InstanceNotExist, "no such instance", "req", 404), nil, false},
+		{"not found status", bce.NewBceServiceError("Unknown", "no such instance", "req", 404), nil, false},
+		{"throttled", bce.NewBceServiceError("RequestLimitExceeded", "slow down", "req", 429), nil, true},
+		{"bad request", bce.NewBceServiceError("InvalidParameter", "bad param", "req", 400), nil, false},
+		{"extra code", bce.NewBceServiceError(ReleaseInstanceFailed, "release failed", "req", 400), []string{ReleaseInstanceFailed}, true},
+		{"message only", Error("Code: " + bce.EINTERNAL_ERROR), nil, false},
+		{"client error", bce.NewBceClientError("unset instance id"), nil, false},
+		{"wrapped", WrapError(bce.NewBceServiceError(bce.EINTERNAL_ERROR, "internal error", "req", 500)), nil, true},
+	}
+
+	for _, c := range cases {
+		if got := IsRetryableScsError(c.err, c.extraCodes...); got != c.expected {
+			t.Errorf("%s: expected %t, got %t", c.name, c.expected, got)
+		}
+	}
+}
+
+func TestIsScsBackoffError(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"internal error", bce.NewBceServiceError(bce.EINTERNAL_ERROR, "internal error", "req", 500), true},
+		{"throttled", bce.NewBceServiceError("RequestLimitExceeded", "slow down", "req", 429), true},
+		{"service unavailable", bce.NewBceServiceError("ServiceUnavailable", "unavailable", "req", 503), false},
+		{"invalid instance status", bce.NewBceServiceError(InvalidInstanceStatus, "instance is busy", "req", 409), false},
+		{"client error", bce.NewBceClientError("unset instance id"), false},
+		{"wrapped", WrapError(bce.NewBceServiceError(bce.EINTERNAL_ERROR, "internal error", "req", 500)), true},
+	}
+
+	for _, c := range cases {
+		if got := IsScsBackoffError(c.err); got != c.expected {
+			t.Errorf("%s: expected %t, got %t", c.name, c.expected, got)
+		}
+	}
+}
+
+func TestIsRequestTimeoutError(t *testing.T) {
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{"nil", nil, false},
+		{"client timeout", bce.NewBceClientError("execute http request failed! Retried 0 times, error: " +
+			"Get http://redis.bj.baidubce.com: net/http: request canceled (Client.Timeout exceeded while awaiting headers)"), true},
+		{"io timeout", bce.NewBceClientError("execute http request failed! Retried 0 times, error: read tcp: i/o timeout"), true},
+		{"client error", bce.NewBceClientError("unset instance id"), false},
+		{"service error", bce.NewBceServiceError("RequestTimeout", "timeout", "req", 408), false},
+		{"wrapped", WrapError(bce.NewBceClientError("context deadline exceeded")), true},
+	}
+
+	for _, c := range cases {
+		if got := IsRequestTimeoutError(c.err); got != c.expected {
+			t.Errorf("%s: expected %t, got %t", c.name, c.expected, got)
+		}
+	}
+}
@@ -1,5 +1,62 @@
 package baiducloud
 
+import (
+	"math"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/scs"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+// ScsEngineVersions are the redis versions which can be created by the scs api in ascending order,
+// the api does not provide a way to list them
+var ScsEngineVersions = []string{"3.2", "4.0"}
+
+// ScsDescriptionTagKey is the tag key to store the description of the instance, which the scs api does not support
+const ScsDescriptionTagKey = "tf:description"
+
+// ScsSkipNodeTypeCheckEnv is the environment variable to skip checking node_type against the spec list when planning,
+// which queries the api and fails offline plans
+const ScsSkipNodeTypeCheckEnv = "BAIDUCLOUD_SKIP_SCS_NODE_TYPE_CHECK"
+
+// ScsImportPasswordEnv is the environment variable to record the current password of an instance when importing,
+// the api never returns the password, so it is taken as the value in the state instead of being set again
+const ScsImportPasswordEnv = "BAIDUCLOUD_SCS_IMPORT_PASSWORD"
+
+// scsInstanceMutex serializes the modifications of a scs instance by its id, the instance only accepts one
+// modification at a time, while baiducloud_scs and the resources attached to it may be applied in parallel
+var scsInstanceMutex = newMutexKV()
+
+// ScsCreatePollDelay is the delay before querying the status of a new instance, which stays in Creating for minutes
+const ScsCreatePollDelay = 30 * time.Second
+
+// ScsReadAfterCreateTimeout is how long the first read of a new instance retries on NotFound, the detail api may not
+// find an instance right after it is created
+const ScsReadAfterCreateTimeout = time.Minute
+
+const (
+	ScsPersistenceModeNone = "none"
+	ScsPersistenceModeRdb  = "rdb"
+	ScsPersistenceModeAof  = "aof"
+
+	// ScsRdbSaveRules are the snapshot rules of the redis default configuration, used when persistence_mode is rdb
+	ScsRdbSaveRules = "900 1 300 10 60 10000"
+)
+
+// ScsPersistenceParameterNames are the parameters managed by persistence_mode, which can not be set in parameters too
+var ScsPersistenceParameterNames = []string{"appendonly", "save"}
+
+const (
+	// ScsRetryBaseDelay is the delay before retrying a failed scs request, and the first delay of the backoff
+	ScsRetryBaseDelay = 2 * time.Second
+	// ScsRetryMaxDelay caps the exponential backoff of retrying a scs request
+	ScsRetryMaxDelay = time.Minute
+)
+
 const (
 	SCSStatusStatusCreating       = "Creating"
 	SCSStatusStatusRunning        = "Running"
@@ -18,3 +75,149 @@ const (
 	SCSStatusStatusFlushFailed    = "Flush failed"
 	SCSSTatusStatusIsolated       = "isolated"
 )
+
+var (
+	// short zone name written by users, such as zoneA
+	scsShortZoneNameRegex = regexp.MustCompile(`^zone([a-z])$`)
+	// fully-qualified zone name returned by the api, such as cn-bj-a
+	scsFullZoneNameRegex = regexp.MustCompile(`^cn-([a-z0-9]+)-([a-z])$`)
+)
+
+// normalizeScsZoneName splits the zone name into its region and zone letter, region is empty for
+// the short form, and letter is empty if the zone name is in neither form
+func normalizeScsZoneName(zoneName string) (region, letter string) {
+	zoneName = strings.ToLower(strings.TrimSpace(zoneName))
+	if match := scsShortZoneNameRegex.FindStringSubmatch(zoneName); match != nil {
+		return "", match[1]
+	}
+	if match := scsFullZoneNameRegex.FindStringSubmatch(zoneName); match != nil {
+		return match[1], match[2]
+	}
+	return zoneName, ""
+}
+
+// scsZoneNameEqual reports whether the two zone names refer to the same zone,
+// the short form matches the fully-qualified form of any region
+func scsZoneNameEqual(a, b string) bool {
+	if strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b)) {
+		return true
+	}
+	regionA, letterA := normalizeScsZoneName(a)
+	regionB, letterB := normalizeScsZoneName(b)
+	if letterA == "" || letterB == "" || letterA != letterB {
+		return false
+	}
+	return regionA == "" || regionB == "" || regionA == regionB
+}
+
+// scsAutoRenew parses the auto renew flag of the instance, which the api returns as a string such as "true"
+func scsAutoRenew(autoRenew string) bool {
+	return strings.EqualFold(strings.TrimSpace(autoRenew), "true")
+}
+
+// scsMemoryUsageRatio returns the used memory divided by the memory capacity rounded to two decimals,
+// it is 0 while the capacity is unknown, e.g. during creating
+func scsMemoryUsageRatio(usedCapacity float64, capacity int) float64 {
+	if capacity <= 0 {
+		return 0
+	}
+	return math.Round(usedCapacity/float64(capacity)*100) / 100
+}
+
+// scsRetryBackoff returns the delay before retrying a scs request which failed with err at attempt (counting from 0).
+// Internal and throttling errors back off exponentially with jitter, so that retries from concurrent
+// applies spread out during an incident of the service, other retryable errors wait ScsRetryBaseDelay.
+func scsRetryBackoff(err error, attempt int) time.Duration {
+	if !IsScsBackoffError(err) {
+		return ScsRetryBaseDelay
+	}
+
+	delay := ScsRetryMaxDelay
+	if attempt < 16 {
+		if d := ScsRetryBaseDelay << uint(attempt); d < ScsRetryMaxDelay {
+			delay = d
+		}
+	}
+	// keep half of the delay and randomize the other half
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// scsExpireTimeAdvanced reports whether the expire time of the instance is later than before,
+// the times are compared as strings if they are not in RFC3339 format
+func scsExpireTimeAdvanced(oldExpireTime, newExpireTime string) bool {
+	oldTime, errOld := time.Parse(time.RFC3339, oldExpireTime)
+	newTime, errNew := time.Parse(time.RFC3339, newExpireTime)
+	if errOld != nil || errNew != nil {
+		return newExpireTime != "" && newExpireTime != oldExpireTime
+	}
+	return newTime.After(oldTime)
+}
+
+// scsPersistenceParameters returns the values of the parameters to apply the persistence mode
+func scsPersistenceParameters(mode string) map[string]string {
+	switch mode {
+	case ScsPersistenceModeAof:
+		return map[string]string{"appendonly": "yes"}
+	case ScsPersistenceModeRdb:
+		return map[string]string{"appendonly": "no", "save": ScsRdbSaveRules}
+	default:
+		return map[string]string{"appendonly": "no", "save": ""}
+	}
+}
+
+// scsPersistenceMode returns the persistence mode according to the parameters of the instance,
+// it is empty if the instance does not report appendonly
+func scsPersistenceMode(parameters map[string]string) string {
+	appendOnly, ok := parameters["appendonly"]
+	if !ok {
+		return ""
+	}
+	if strings.EqualFold(appendOnly, "yes") {
+		return ScsPersistenceModeAof
+	}
+	if strings.TrimSpace(parameters["save"]) != "" {
+		return ScsPersistenceModeRdb
+	}
+	return ScsPersistenceModeNone
+}
+
+// scsMaxConnections returns the maximum client connections of the node type in the spec list of the architecture,
+// it is 0 if the node type is not found
+func scsMaxConnections(clusterType, nodeType string, specs *scs.GetNodeTypeListResult) int {
+	nodeTypes := specs.DefaultNodeTypeList
+	if clusterType == "cluster" {
+		nodeTypes = specs.ClusterNodeTypeList
+	}
+
+	for _, spec := range nodeTypes {
+		if spec.NodeType == nodeType {
+			return spec.MaxConnections
+		}
+	}
+	return 0
+}
+
+// diffScsTags returns the tags to unbind and to bind to replace oldTags of the instance with newTags entirely.
+// A removed tag is unbound, a changed tag is unbound with the old value and bound again with the new value,
+// the tags ignored by the provider are managed outside of terraform and left untouched.
+func diffScsTags(client *connectivity.BaiduClient, oldTags, newTags map[string]interface{}) (unbindTags, bindTags map[string]interface{}) {
+	unbindTags = make(map[string]interface{})
+	for key, value := range oldTags {
+		if client.IgnoreTag(key) {
+			continue
+		}
+		if newValue, ok := newTags[key]; !ok || newValue != value {
+			unbindTags[key] = value
+		}
+	}
+	bindTags = make(map[string]interface{})
+	for key, value := range newTags {
+		if client.IgnoreTag(key) {
+			continue
+		}
+		if oldValue, ok := oldTags[key]; !ok || oldValue != value {
+			bindTags[key] = value
+		}
+	}
+	return unbindTags, bindTags
+}
@@ -0,0 +1,197 @@
+package baiducloud
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
+	"github.com/baidubce/bce-sdk-go/services/scs"
+)
+
+func TestScsZoneNameEqual(t *testing.T) {
+	cases := []struct {
+		a, b     string
+		expected bool
+	}{
+		{"cn-bj-a", "cn-bj-a", true},
+		{"zoneA", "cn-bj-a", true},
+		{"cn-gz-c", "zoneC", true},
+		{"zonea", "ZoneA", true},
+		{" cn-bj-a", "CN-BJ-A", true},
+		{"zoneA", "cn-bj-b", false},
+		{"cn-bj-a", "cn-gz-a", false},
+		{"zoneA", "zoneB", false},
+		{"zoneA", "", false},
+		{"custom-zone", "zoneA", false},
+	}
+
+	for _, c := range cases {
+		if got := scsZoneNameEqual(c.a, c.b); got != c.expected {
+			t.Errorf("scsZoneNameEqual(%q, %q): expected %t, got %t", c.a, c.b, c.expected, got)
+		}
+	}
+}
+
+func TestScsAutoRenew(t *testing.T) {
+	for value, expected := range map[string]bool{"true": true, "True": true, " true ": true, "false": false, "": false, "1": false} {
+		if got := scsAutoRenew(value); got != expected {
+			t.Errorf("scsAutoRenew(%q): expected %t, got %t", value, expected, got)
+		}
+	}
+}
+
+func TestScsMemoryUsageRatio(t *testing.T) {
+	cases := []struct {
+		used     float64
+		capacity int
+		expected float64
+	}{
+		{0, 0, 0},
+		{1, 0, 0},
+		{0, 4, 0},
+		{1, 4, 0.25},
+		{1, 3, 0.33},
+		{2, 3, 0.67},
+		{8, 8, 1},
+	}
+
+	for _, c := range cases {
+		if got := scsMemoryUsageRatio(c.used, c.capacity); got != c.expected {
+			t.Errorf("scsMemoryUsageRatio(%v, %d): expected %v, got %v", c.used, c.capacity, c.expected, got)
+		}
+	}
+}
+
+func TestScsRetryBackoff(t *testing.T) {
+	internalErr := bce.NewBceServiceError(bce.EINTERNAL_ERROR, "internal error", "req", 500)
+	throttledErr := bce.NewBceServiceError("RequestLimitExceeded", "slow down", "req", 429)
+	busyErr := bce.NewBceServiceError(InvalidInstanceStatus, "instance is busy", "req", 409)
+
+	cases := []struct {
+		name     string
+		err      error
+		attempt  int
+		min, max time.Duration
+	}{
+		{"internal first", internalErr, 0, time.Second, 2 * time.Second},
+		{"internal third", internalErr, 2, 4 * time.Second, 8 * time.Second},
+		{"throttled second", throttledErr, 1, 2 * time.Second, 4 * time.Second},
+		{"internal capped", internalErr, 10, ScsRetryMaxDelay / 2, ScsRetryMaxDelay},
+		{"internal overflow", internalErr, 100, ScsRetryMaxDelay / 2, ScsRetryMaxDelay},
+		{"fixed", busyErr, 5, ScsRetryBaseDelay, ScsRetryBaseDelay},
+	}
+
+	for _, c := range cases {
+		for i := 0; i < 20; i++ {
+			if got := scsRetryBackoff(c.err, c.attempt); got < c.min || got > c.max {
+				t.Errorf("%s: expected delay in [%s, %s], got %s", c.name, c.min, c.max, got)
+				break
+			}
+		}
+	}
+}
+
+func TestScsExpireTimeAdvanced(t *testing.T) {
+	cases := []struct {
+		old, new string
+		expected bool
+	}{
+		{"2020-07-01T00:00:00Z", "2020-08-01T00:00:00Z", true},
+		{"2020-07-01T00:00:00Z", "2020-07-01T00:00:00Z", false},
+		{"2020-07-01T00:00:00Z", "2020-06-01T00:00:00Z", false},
+		{"2020-07-01T08:00:00+08:00", "2020-07-01T00:00:00Z", false},
+		{"", "2020-08-01T00:00:00Z", true},
+		{"2020-07-01", "2020-08-01", true},
+		{"2020-07-01", "", false},
+	}
+
+	for _, c := range cases {
+		if got := scsExpireTimeAdvanced(c.old, c.new); got != c.expected {
+			t.Errorf("scsExpireTimeAdvanced(%q, %q): expected %t, got %t", c.old, c.new, c.expected, got)
+		}
+	}
+}
+
+func TestScsPersistenceMode(t *testing.T) {
+	cases := []struct {
+		parameters map[string]string
+		expected   string
+	}{
+		{map[string]string{}, ""},
+		{map[string]string{"appendonly": "yes", "save": ""}, ScsPersistenceModeAof},
+		{map[string]string{"appendonly": "no", "save": ScsRdbSaveRules}, ScsPersistenceModeRdb},
+		{map[string]string{"appendonly": "no", "save": " "}, ScsPersistenceModeNone},
+		{map[string]string{"appendonly": "no"}, ScsPersistenceModeNone},
+	}
+
+	for _, c := range cases {
+		if actual := scsPersistenceMode(c.parameters); actual != c.expected {
+			t.Errorf("scsPersistenceMode(%v): expected %q, got %q", c.parameters, c.expected, actual)
+		}
+	}
+
+	// the parameters applied for a mode are read back as the same mode
+	for _, mode := range []string{ScsPersistenceModeNone, ScsPersistenceModeRdb, ScsPersistenceModeAof} {
+		if actual := scsPersistenceMode(scsPersistenceParameters(mode)); actual != mode {
+			t.Errorf("scsPersistenceMode(scsPersistenceParameters(%q)): expected %q, got %q", mode, mode, actual)
+		}
+	}
+}
+
+func TestScsMaxConnections(t *testing.T) {
+	specs := &scs.GetNodeTypeListResult{
+		DefaultNodeTypeList: []scs.NodeType{
+			{NodeType: "cache.n1.micro", MaxConnections: 10000},
+		},
+		ClusterNodeTypeList: []scs.NodeType{
+			{NodeType: "cache.n1.micro", MaxConnections: 20000},
+		},
+	}
+
+	cases := []struct {
+		clusterType, nodeType string
+		expected              int
+	}{
+		{"master_slave", "cache.n1.micro", 10000},
+		{"cluster", "cache.n1.micro", 20000},
+		{"cluster", "cache.n1.small", 0},
+	}
+
+	for _, c := range cases {
+		if actual := scsMaxConnections(c.clusterType, c.nodeType, specs); actual != c.expected {
+			t.Errorf("scsMaxConnections(%q, %q): expected %d, got %d", c.clusterType, c.nodeType, c.expected, actual)
+		}
+	}
+}
+
+func TestDiffScsTags(t *testing.T) {
+	client := newIgnoreTagsClient(t, nil, []string{"finance:"})
+
+	oldTags := map[string]interface{}{
+		"removed":       "a",
+		"changed":       "b",
+		"kept":          "c",
+		"finance:owner": "ops",
+	}
+	newTags := map[string]interface{}{
+		"changed": "B",
+		"kept":    "c",
+		"added":   "d",
+	}
+
+	unbindTags, bindTags := diffScsTags(client, oldTags, newTags)
+	expectedUnbind := map[string]interface{}{"removed": "a", "changed": "b"}
+	if !reflect.DeepEqual(unbindTags, expectedUnbind) {
+		t.Errorf("expected to unbind %v, got %v", expectedUnbind, unbindTags)
+	}
+	expectedBind := map[string]interface{}{"changed": "B", "added": "d"}
+	if !reflect.DeepEqual(bindTags, expectedBind) {
+		t.Errorf("expected to bind %v, got %v", expectedBind, bindTags)
+	}
+
+	unbindTags, bindTags = diffScsTags(client, newTags, newTags)
+	if len(unbindTags) != 0 || len(bindTags) != 0 {
+		t.Errorf("expected no change for the same tags, got unbind %v and bind %v", unbindTags, bindTags)
+	}
+}
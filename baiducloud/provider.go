@@ -39,12 +39,21 @@ Data Sources
   baiducloud_snapshots
   baiducloud_auto_snapshot_policies
   baiducloud_zones
+  baiducloud_caller_identity
   baiducloud_specs
   baiducloud_images
   baiducloud_certs
   baiducloud_cfc_function
+  baiducloud_scs
   baiducloud_scs_specs
   baiducloud_scss
+  baiducloud_scs_instances
+  baiducloud_scs_slowlog
+  baiducloud_scs_backups
+  baiducloud_scs_zones
+  baiducloud_scs_security_ips
+  baiducloud_scs_instance_status
+  baiducloud_scs_engine_versions
   baiducloud_cce_versions
   baiducloud_cce_container_net
   baiducloud_cce_cluster_nodes
@@ -97,6 +106,8 @@ CFC Resources
 
 SCS Resources
   baiducloud_scs
+  baiducloud_scs_security_ip
+  baiducloud_scs_flush
 
 DTS Resources
   baiducloud_dts
@@ -126,15 +137,17 @@ import (
 
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/hashicorp/terraform/terraform"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
 
 const (
-	PROVIDER_ACCESS_KEY = "BAIDUCLOUD_ACCESS_KEY"
-	PROVIDER_SECRET_KEY = "BAIDUCLOUD_SECRET_KEY"
-	PROVIDER_REGION     = "BAIDUCLOUD_REGION"
+	PROVIDER_ACCESS_KEY     = "BAIDUCLOUD_ACCESS_KEY"
+	PROVIDER_SECRET_KEY     = "BAIDUCLOUD_SECRET_KEY"
+	PROVIDER_SECURITY_TOKEN = "BAIDUCLOUD_SECURITY_TOKEN"
+	PROVIDER_REGION         = "BAIDUCLOUD_REGION"
 )
 
 func Provider() terraform.ResourceProvider {
@@ -153,6 +166,13 @@ func Provider() terraform.ResourceProvider {
 				Description: descriptions["secret_key"],
 				Sensitive:   true,
 			},
+			"security_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(PROVIDER_SECURITY_TOKEN, ""),
+				Description: descriptions["security_token"],
+				Sensitive:   true,
+			},
 			"region": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -163,6 +183,34 @@ func Provider() terraform.ResourceProvider {
 			"endpoints": endpointsSchema(),
 
 			"assume_role": assumeRoleSchema(),
+
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  descriptions["max_retries"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"retry_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      connectivity.DefaultRetryIntervalInMillis,
+				Description:  descriptions["retry_interval"],
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"connection_timeout_ms": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: descriptions["connection_timeout_ms"],
+				// the sdk counts the timeout in whole seconds
+				ValidateFunc: validation.IntAtLeast(1000),
+			},
+			"debug": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("BAIDUCLOUD_DEBUG", false),
+				Description: descriptions["debug"],
+			},
+			"ignore_tags": ignoreTagsSchema(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
@@ -189,8 +237,17 @@ func Provider() terraform.ResourceProvider {
 			"baiducloud_specs":                          dataSourceBaiduCloudSpecs(),
 			"baiducloud_images":                         dataSourceBaiduCloudImages(),
 			"baiducloud_cfc_function":                   dataSourceBaiduCloudCFCFunction(),
+			"baiducloud_scs":                            dataSourceBaiduCloudScs(),
 			"baiducloud_scs_specs":                      dataSourceBaiduCloudScsSpecs(),
 			"baiducloud_scss":                           dataSourceBaiduCloudScss(),
+			"baiducloud_scs_instances":                  dataSourceBaiduCloudScsInstances(),
+			"baiducloud_scs_slowlog":                    dataSourceBaiduCloudScsSlowlog(),
+			"baiducloud_scs_backups":                    dataSourceBaiduCloudScsBackups(),
+			"baiducloud_scs_zones":                      dataSourceBaiduCloudScsZones(),
+			"baiducloud_scs_security_ips":               dataSourceBaiduCloudScsSecurityIps(),
+			"baiducloud_scs_instance_status":            dataSourceBaiduCloudScsInstanceStatus(),
+			"baiducloud_scs_engine_versions":            dataSourceBaiduCloudScsEngineVersions(),
+			"baiducloud_caller_identity":                dataSourceBaiduCloudCallerIdentity(),
 			"baiducloud_cce_versions":                   dataSourceBaiduCloudCceKubernetesVersion(),
 			"baiducloud_cce_container_net":              dataSourceBaiduCloudCceContainerNet(),
 			"baiducloud_cce_cluster_nodes":              dataSourceBaiduCloudCCEClusterNodes(),
@@ -231,6 +288,8 @@ func Provider() terraform.ResourceProvider {
 			"baiducloud_cfc_version":                 resourceBaiduCloudCFCVersion(),
 			"baiducloud_cfc_trigger":                 resourceBaiduCloudCFCTrigger(),
 			"baiducloud_scs":                         resourceBaiduCloudScs(),
+			"baiducloud_scs_security_ip":             resourceBaiduCloudScsSecurityIp(),
+			"baiducloud_scs_flush":                   resourceBaiduCloudScsFlush(),
 			"baiducloud_cce_cluster":                 resourceBaiduCloudCCECluster(),
 			"baiducloud_ccev2_cluster":               resourceBaiduCloudCCEv2Cluster(),
 			"baiducloud_ccev2_instance":              resourceBaiduCloudCCEv2Instance(),
@@ -259,6 +318,8 @@ func init() {
 
 		"secret_key": "The Secret key of BaiduCloud for API operations. You can retrieve this from the 'Security Management' section of the BaiduCloud console.",
 
+		"security_token": "The session token of the STS temporary credentials, it is required when access_key and secret_key are temporary credentials.",
+
 		"region": "The region where BaiduCloud operations will take place. Examples are bj, su, gz, etc.",
 
 		"assume_role_name": "The role name for assume role.",
@@ -269,6 +330,18 @@ func init() {
 
 		"assume_role_acl": "The acl for this assume role.",
 
+		"max_retries": "The maximum number of times an API request is retried when it fails with a retryable error. Set to 0 to disable retries. Default to the sdk policy, which retries 3 times.",
+
+		"retry_interval": "The base interval in milliseconds between retries of an API request, it doubles after each retry. It applies to the default 3 retries too if max_retries is not set. Default to 300.",
+
+		"connection_timeout_ms": "The timeout in milliseconds of an API request, it is rounded down to whole seconds. Default to the sdk timeout, which is 1200 seconds.",
+
+		"ignore_tags_keys": "The tag keys to be ignored by all resources and data sources, such as tags managed outside of Terraform.",
+
+		"ignore_tags_key_prefixes": "The tag key prefixes to be ignored by all resources and data sources, such as tags managed outside of Terraform.",
+
+		"debug": "Whether to log every API action and its response, with sensitive fields such as password redacted. It can also be sourced from the `BAIDUCLOUD_DEBUG` environment variable. Default to false.",
+
 		"bcc_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom BCC endpoints.",
 
 		"vpc_endpoint": "Use this to override the default endpoint URL constructed from the `region`. It's typically used to connect to custom VPC endpoints.",
@@ -300,70 +373,81 @@ func endpointsSchema() *schema.Schema {
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"bcc": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["bcc_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["bcc_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"vpc": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["vpc_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["vpc_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"eip": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["eip_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["eip_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"appblb": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["appblb_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["appblb_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"bos": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["bos_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["bos_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"cfc": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cfc_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["cfc_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"scs": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["scs_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["scs_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"cce": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["cce_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["cce_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"ccev2": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["ccev2_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["ccev2_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"rds": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["rds_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["rds_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 				"dts": {
-					Type:        schema.TypeString,
-					Optional:    true,
-					Default:     "",
-					Description: descriptions["dts_endpoint"],
+					Type:         schema.TypeString,
+					Optional:     true,
+					Default:      "",
+					Description:  descriptions["dts_endpoint"],
+					ValidateFunc: validateEndpoint(),
 				},
 			},
 		},
@@ -403,9 +487,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	config := connectivity.Config{
-		AccessKey: accessKey.(string),
-		SecretKey: secretKey.(string),
-		Region:    connectivity.Region(region.(string)),
+		AccessKey:     accessKey.(string),
+		SecretKey:     secretKey.(string),
+		SecurityToken: d.Get("security_token").(string),
 	}
 
 	assumeRoleList, ok := d.GetOk("assume_role")
@@ -431,6 +515,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		}
 	}
 
+	if v, ok := d.GetOkExists("max_retries"); ok {
+		maxRetries := v.(int)
+		config.MaxRetries = &maxRetries
+	}
+	config.RetryInterval = d.Get("retry_interval").(int)
+	config.ConnectionTimeout = d.Get("connection_timeout_ms").(int)
+	config.Debug = d.Get("debug").(bool)
+	config.IgnoreTagKeys, config.IgnoreTagKeyPrefixes = expandIgnoreTags(d.Get("ignore_tags").([]interface{}))
+
+	config.ConfigEndpoints = make(connectivity.ConfigEndpoints)
 	endpointsSet := d.Get("endpoints").(*schema.Set)
 
 	for _, endpointsSetI := range endpointsSet.List() {
@@ -440,7 +534,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.ConfigEndpoints[connectivity.EIPCode] = strings.TrimSpace(endpoints["eip"].(string))
 		config.ConfigEndpoints[connectivity.APPBLBCode] = strings.TrimSpace(endpoints["appblb"].(string))
 		config.ConfigEndpoints[connectivity.BOSCode] = strings.TrimSpace(endpoints["bos"].(string))
-		config.ConfigEndpoints[connectivity.BOSCode] = strings.TrimSpace(endpoints["cfc"].(string))
+		config.ConfigEndpoints[connectivity.CFCCode] = strings.TrimSpace(endpoints["cfc"].(string))
 		config.ConfigEndpoints[connectivity.SCSCode] = strings.TrimSpace(endpoints["scs"].(string))
 		config.ConfigEndpoints[connectivity.CCECode] = strings.TrimSpace(endpoints["cce"].(string))
 		config.ConfigEndpoints[connectivity.CCEv2Code] = strings.TrimSpace(endpoints["ccev2"].(string))
@@ -448,6 +542,12 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.ConfigEndpoints[connectivity.DTSCode] = strings.TrimSpace(endpoints["dts"].(string))
 	}
 
+	validRegion, err := checkRegion(region.(string), config.ConfigEndpoints)
+	if err != nil {
+		return nil, err
+	}
+	config.Region = validRegion
+
 	client, err := config.Client()
 	if err != nil {
 		return nil, err
@@ -491,3 +591,29 @@ func assumeRoleSchema() *schema.Schema {
 		},
 	}
 }
+
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Tags to be ignored by all resources and data sources, they are neither read into the state nor modified.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: descriptions["ignore_tags_keys"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Description: descriptions["ignore_tags_key_prefixes"],
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
@@ -222,7 +222,7 @@ func resourceBaiduCloudAppBLBRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("create_time", blbDetail.CreateTime)
 	d.Set("release_time", blbDetail.ReleaseTime)
 	d.Set("listener", appblbService.FlattenListenerModelToMap(blbDetail.Listener))
-	d.Set("tags", flattenTagsToMap(blbModel.Tags))
+	d.Set("tags", flattenTagsToMap(client, blbModel.Tags))
 
 	return nil
 }
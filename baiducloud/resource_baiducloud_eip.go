@@ -215,7 +215,7 @@ func resourceBaiduCloudEipRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("billing_method", result.BillingMethod)
 	d.Set("create_time", result.CreateTime)
 	d.Set("expire_time", result.ExpireTime)
-	d.Set("tags", flattenTagsToMap(result.Tags))
+	d.Set("tags", flattenTagsToMap(client, result.Tags))
 	d.Set("eip", result.Eip)
 
 	return nil
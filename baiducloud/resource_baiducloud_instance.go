@@ -513,7 +513,7 @@ func resourceBaiduCloudInstanceRead(d *schema.ResourceData, meta interface{}) er
 	d.Set("fpga_card", response.Instance.FpgaCard)
 	d.Set("card_count", response.Instance.CardCount)
 	d.Set("dedicate_host_id", response.Instance.DedicatedHostId)
-	d.Set("tags", flattenTagsToMap(response.Instance.Tags))
+	d.Set("tags", flattenTagsToMap(client, response.Instance.Tags))
 
 	billingMap := map[string]interface{}{"payment_timing": response.Instance.PaymentTiming}
 	d.Set("billing", billingMap)
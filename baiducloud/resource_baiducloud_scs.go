@@ -3,6 +3,11 @@ Use this resource to get information about a SCS.
 
 ~> **NOTE:** The terminate operation of scs does NOT take effect immediately，maybe takes for several minites.
 
+~> **NOTE:** Changing `vpc_id`, `vpc_name` or `subnets` recreates the instance and all of its data is lost, since SCS can not migrate an instance to another network. Back up the data before changing them.
+
+~> **NOTE:** A name changed in the console is renamed back to `instance_name` by the next apply. To keep the name managed
+outside of terraform, add `instance_name` to `ignore_changes` of the `lifecycle` block.
+
 Example Usage
 
 ```hcl
@@ -28,15 +33,27 @@ SCS can be imported, e.g.
 ```hcl
 $ terraform import baiducloud_scs.default id
 ```
+
+The api does not return the password of the instance. Set the current password in the environment variable
+`BAIDUCLOUD_SCS_IMPORT_PASSWORD` when importing, so that the same password in the config does not modify it again.
+
+```hcl
+$ BAIDUCLOUD_SCS_IMPORT_PASSWORD=xxxxxx terraform import baiducloud_scs.default id
+```
 */
 package baiducloud
 
 import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/scs"
-	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
 
@@ -51,9 +68,11 @@ func resourceBaiduCloudScs() *schema.Resource {
 		Delete: resourceBaiduCloudScsDelete,
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceBaiduCloudScsImport,
 		},
 
+		CustomizeDiff: resourceBaiduCloudScsCustomizeDiff,
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
@@ -62,20 +81,33 @@ func resourceBaiduCloudScs() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"purchase_count": {
-				Type:        schema.TypeInt,
-				Description: "Count of the instance to buy",
-				Default:     1,
-				Optional:    true,
+				Type:         schema.TypeInt,
+				Description:  "Count of the instance to buy. Only 1 is supported, use count or for_each to create more instances.",
+				Default:      1,
+				Optional:     true,
+				ValidateFunc: validateScsPurchaseCount(),
+			},
+			"client_token": {
+				Type:             schema.TypeString,
+				Description:      "Idempotency token of the create request. The instance created before is returned when creating with the same token again, so that retrying after a network failure does not create another instance. It is only used when creating the instance, it is cleared from the state once the instance is created and changing it afterwards has no effect. It is still kept in a saved plan file.",
+				Optional:         true,
+				Sensitive:        true,
+				ValidateFunc:     validation.StringLenBetween(1, 64),
+				DiffSuppressFunc: scsClientTokenDiffSuppressFunc,
 			},
 			"instance_name": {
-				Type:        schema.TypeString,
-				Description: "Name of the instance. Support for uppercase and lowercase letters, numbers, Chinese and special characters, such as \"-\",\"_\",\"/\",\".\", the value must start with a letter, length 1-65.",
-				Required:    true,
+				Type:             schema.TypeString,
+				Description:      "Name of the instance. Support for uppercase and lowercase letters, numbers, Chinese and special characters, such as \"-\",\"_\",\"/\",\".\", the value must start with a letter, length 1-65.",
+				Required:         true,
+				ValidateFunc:     validateScsInstanceName(),
+				DiffSuppressFunc: trimSpaceDiffSuppressFunc,
 			},
 			"node_type": {
-				Type:        schema.TypeString,
-				Description: "Type of the instance. Available values are cache.n1.micro, cache.n1.small, cache.n1.medium...cache.n1hs3.4xlarge.",
-				Required:    true,
+				Type:          schema.TypeString,
+				Description:   "Type of the instance. Available values are cache.n1.micro, cache.n1.small, cache.n1.medium...cache.n1hs3.4xlarge. If node_type and shard_num are changed in one apply, node_type is resized first, and shard_num is resized after the instance is running again. One of node_type and capacity must be set. It is checked against the spec list of the cluster_type when planning, which can be skipped by setting the BAIDUCLOUD_SKIP_SCS_NODE_TYPE_CHECK environment variable for offline plans.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"capacity"},
 			},
 			"shard_num": {
 				Type:        schema.TypeInt,
@@ -83,6 +115,38 @@ func resourceBaiduCloudScs() *schema.Resource {
 				Default:     1,
 				Optional:    true,
 			},
+			"create_poll_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Interval in seconds between the status queries while waiting for the instance to be created. The first query is sent 30 seconds after the create request since a new instance stays in Creating for minutes. Default to 0, which polls with the default interval that starts at 3 seconds and backs off up to 10 seconds.",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 600),
+			},
+			"delete_poll_interval": {
+				Type:         schema.TypeInt,
+				Description:  "Interval in seconds between the status queries while waiting for the instance to be deleted, it is also the delay before the first query. Default to 0, which polls with the default interval that starts at 3 seconds and backs off up to 10 seconds.",
+				Optional:     true,
+				Default:      0,
+				ValidateFunc: validation.IntBetween(0, 600),
+			},
+			"allow_shrink": {
+				Type:        schema.TypeBool,
+				Description: "Whether shard_num of the cluster instance is allowed to be decreased. Decreasing shard_num migrates the data to fewer shards and may lose data if the remaining shards can not hold it, so it is rejected unless allow_shrink is true. Default to false.",
+				Optional:    true,
+				Default:     false,
+			},
+			"delete_on_isolation": {
+				Type:        schema.TypeBool,
+				Description: "Whether to release the instance from the recycle bin when destroying it. Deleting an instance, and the payment failure of a Postpaid instance, only isolates it in the recycle bin where it is kept for days, set it to true to release the isolated instance entirely and reclaim it. Default to false.",
+				Optional:    true,
+				Default:     false,
+			},
+			"adopt_existing": {
+				Type:        schema.TypeBool,
+				Description: "Whether to adopt the existing instance with the same instance_name instead of creating a new one, such as the instance created by a previous apply which timed out while waiting for it to be running. It is only used when creating the instance, and the adopted instance is updated to match the configuration afterwards. Default to false.",
+				Optional:    true,
+				Default:     false,
+			},
 			"proxy_num": {
 				Type:        schema.TypeInt,
 				Description: "The number of instance proxy.",
@@ -98,17 +162,32 @@ func resourceBaiduCloudScs() *schema.Resource {
 				ForceNew:    true,
 			},
 			"port": {
-				Type:        schema.TypeInt,
-				Description: "The port used to access a instance.",
-				Optional:    true,
-				Default:     6379,
-				ForceNew:    true,
+				Type:         schema.TypeInt,
+				Description:  "The port used to access a instance, valid values are 1025-65534. Default to 6379.",
+				Optional:     true,
+				Default:      6379,
+				ForceNew:     true,
+				ValidateFunc: validateScsPort(),
+			},
+			"password": {
+				Type:         schema.TypeString,
+				Description:  "Access password of the instance. This value should be 8-16 characters, and letters, numbers and symbols must exist at the same time. The symbols is limited to \"!@#$%^*()\". If cluster_type is cluster, the password is authenticated by the proxy instead of each shard.",
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 16),
 			},
 			"domain": {
 				Type:        schema.TypeString,
 				Description: "Domain of the instance.",
 				Computed:    true,
 			},
+			"domain_prefix": {
+				Type:         schema.TypeString,
+				Description:  "Prefix of the domain of the instance, which is the part before the first dot. It can be set to a custom prefix to get a predictable domain, and is computed from the domain if not set.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-z][a-z0-9-]*$`), "must start with a lowercase letter and contain only lowercase letters, numbers and -"),
+			},
 			"cluster_type": {
 				Type:         schema.TypeString,
 				Description:  "Type of the instance,  Available values are cluster, master_slave.",
@@ -123,7 +202,7 @@ func resourceBaiduCloudScs() *schema.Resource {
 				Optional:     true,
 				Default:      "3.2",
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"3.2", "4.0"}, false),
+				ValidateFunc: validation.StringInSlice(ScsEngineVersions, false),
 			},
 			"engine": {
 				Type:        schema.TypeString,
@@ -131,11 +210,19 @@ func resourceBaiduCloudScs() *schema.Resource {
 				Computed:    true,
 			},
 			"vpc_id": {
-				Type:        schema.TypeString,
-				Description: "ID of the specific VPC",
-				Optional:    true,
-				Computed:    true,
-				ForceNew:    true,
+				Type:          schema.TypeString,
+				Description:   "ID of the specific VPC. The instance can not be migrated to another VPC, so changing it destroys the instance with all its data and creates a new one. If it is set, at least one subnet must be set in subnets.",
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"vpc_name"},
+			},
+			"vpc_name": {
+				Type:          schema.TypeString,
+				Description:   "Name of the specific VPC, it is resolved to vpc_id when creating the instance and must match exactly one VPC. If it is set, at least one subnet must be set in subnets. Conflicts with vpc_id.",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"vpc_id"},
 			},
 			"v_net_ip": {
 				Type:        schema.TypeString,
@@ -144,7 +231,7 @@ func resourceBaiduCloudScs() *schema.Resource {
 			},
 			"subnets": {
 				Type:        schema.TypeList,
-				Description: "Subnets of the instance.",
+				Description: "Subnets of the instance. Changing subnet_id or zone_name of a subnet destroys the instance with all its data and creates a new one, because the instance can not be migrated to other subnets.",
 				Optional:    true,
 				Computed:    true,
 				Elem: &schema.Resource{
@@ -157,15 +244,80 @@ func resourceBaiduCloudScs() *schema.Resource {
 							ForceNew:    true,
 						},
 						"zone_name": {
+							Type:             schema.TypeString,
+							Description:      "Zone name of the subnet, such as cn-bj-a. The short form such as zoneA is treated as the same zone.",
+							Optional:         true,
+							Computed:         true,
+							ForceNew:         true,
+							DiffSuppressFunc: scsZoneNameDiffSuppressFunc,
+						},
+					},
+				},
+			},
+			"parameters": {
+				Type:        schema.TypeSet,
+				Description: "Configuration parameters of the instance, such as maxmemory-policy and timeout. Only the parameters set here are managed, others keep their current values.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "Name of the parameter.",
+							Required:     true,
+							ValidateFunc: validateScsParameterName(),
+						},
+						"value": {
 							Type:        schema.TypeString,
-							Description: "Zone name of the subnet.",
-							Optional:    true,
-							Computed:    true,
-							ForceNew:    true,
+							Description: "Value of the parameter.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			"persistence_mode": {
+				Type:         schema.TypeString,
+				Description:  "Persistence mode of the instance, valid values are none, rdb and aof. It is applied by the parameters appendonly and save, which can not be set in parameters at the same time. Both engine_version 3.2 and 4.0 support aof. If not set, the current mode of the instance is kept.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{ScsPersistenceModeNone, ScsPersistenceModeRdb, ScsPersistenceModeAof}, false),
+			},
+			"backup_config": {
+				Type:        schema.TypeList,
+				Description: "Automatic backup policy of the instance. If not set, the backup policy of the instance is left untouched.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_days": {
+							Type:         schema.TypeString,
+							Description:  "Days of the week to backup, separated by commas, such as Mon,Wed,Fri. Available values are Mon, Tue, Wed, Thu, Fri, Sat, Sun.",
+							Required:     true,
+							ValidateFunc: validateScsBackupDays(),
+						},
+						"backup_time": {
+							Type:         schema.TypeString,
+							Description:  "UTC start time of the backup window, in the format HH:mm:ss, such as 01:05:00.",
+							Required:     true,
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d:[0-5]\d$`), "backup_time must be in the format HH:mm:ss"),
+						},
+						"expire_day": {
+							Type:         schema.TypeInt,
+							Description:  "Days to keep the backups. Default to 7.",
+							Optional:     true,
+							Default:      7,
+							ValidateFunc: validation.IntAtLeast(1),
 						},
 					},
 				},
 			},
+			"security_group_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the security groups bound to the instance. The security groups should belong to the same VPC as the instance.",
+				Optional:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 			"billing": {
 				Type:        schema.TypeMap,
 				Description: "Billing information of the Scs.",
@@ -174,7 +326,7 @@ func resourceBaiduCloudScs() *schema.Resource {
 					Schema: map[string]*schema.Schema{
 						"payment_timing": {
 							Type:         schema.TypeString,
-							Description:  "Payment timing of billing, which can be Prepaid or Postpaid. The default is Postpaid.",
+							Description:  "Payment timing of billing, which can be Prepaid or Postpaid. The default is Postpaid. It can not be changed after the instance is created.",
 							Required:     true,
 							Default:      PaymentTimingPostpaid,
 							ValidateFunc: validatePaymentTiming(),
@@ -209,21 +361,54 @@ func resourceBaiduCloudScs() *schema.Resource {
 				},
 			},
 			"auto_renew_time_unit": {
-				Type:        schema.TypeString,
-				Description: "Time unit of automatic renewal, the value can be month or year. The default value is empty, indicating no automatic renewal. It is valid only when the payment_timing is Prepaid.",
-				Computed:    true,
+				Type:             schema.TypeString,
+				Description:      "Time unit of automatic renewal, the value can be month or year. Default to month. It is valid only when the payment_timing is Prepaid and auto_renew is true, and can only be set when creating the instance.",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringInSlice([]string{"month", "year"}, false),
+				DiffSuppressFunc: scsAutoRenewDiffSuppressFunc,
 			},
 			"auto_renew_time_length": {
-				Type:        schema.TypeInt,
-				Description: "The time length of automatic renewal. It is valid when payment_timing is Prepaid, and the value should be 1-9 when the auto_renew_time_unit is month and 1-3 when the auto_renew_time_unit is year. Default to 1.",
-				Computed:    true,
+				Type:             schema.TypeInt,
+				Description:      "The time length of automatic renewal. It is valid when payment_timing is Prepaid and auto_renew is true, and the value should be 1-9 when the auto_renew_time_unit is month and 1-3 when the auto_renew_time_unit is year. Default to 1. It can only be set when creating the instance.",
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.IntBetween(1, 9),
+				DiffSuppressFunc: scsAutoRenewDiffSuppressFunc,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Description:  "Description of the instance, support modify. The scs api has no description field, so it is stored as the tag tf:description, which is excluded from tags.",
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 65),
+			},
+			"tags": {
+				Type:         schema.TypeMap,
+				Description:  "Tags of the instance, support modify. Keys start with bce: or baidu: are reserved by the system, and tf:description is reserved for description.",
+				Optional:     true,
+				ValidateFunc: validateScsTags(),
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
-			"tags": tagsComputedSchema(),
 			"auto_renew": {
 				Type:        schema.TypeBool,
-				Description: "Whether to automatically renew.",
+				Description: "Whether to automatically renew. It is valid only when the payment_timing is Prepaid, and can only be set when creating the instance.",
+				Optional:    true,
 				Computed:    true,
 			},
+			"renew_time_length": {
+				Type:         schema.TypeInt,
+				Description:  "The months to renew the Prepaid instance for when renew_triggers changes. Valid values: [1, 2, 3, 4, 5, 6, 7, 8, 9, 12, 24, 36]. Default to 1.",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validateReservationLength(),
+			},
+			"renew_triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, renews the Prepaid instance for renew_time_length months and waits until expire_time advances. Setting it when creating the instance does not renew it.",
+				Optional:    true,
+			},
 			"instance_id": {
 				Type:        schema.TypeString,
 				Description: "ID of the instance.",
@@ -245,8 +430,17 @@ func resourceBaiduCloudScs() *schema.Resource {
 				Computed:    true,
 			},
 			"capacity": {
-				Type:        schema.TypeInt,
-				Description: "Memory capacity(GB) of the instance.",
+				Type:             schema.TypeInt,
+				Description:      "Memory capacity(GB) of the instance. It can be set instead of node_type, then the smallest node_type whose total capacity is not less than it is chosen. Increasing it resizes the instance, decreasing it does nothing because the instance already meets the capacity.",
+				Optional:         true,
+				Computed:         true,
+				ConflictsWith:    []string{"node_type"},
+				ValidateFunc:     validation.IntAtLeast(1),
+				DiffSuppressFunc: scsCapacityDiffSuppressFunc,
+			},
+			"is_isolated": {
+				Type:        schema.TypeBool,
+				Description: "Whether the instance is isolated because it is expired or in arrears. An isolated instance can not be accessed until it is renewed.",
 				Computed:    true,
 			},
 			"used_capacity": {
@@ -254,6 +448,16 @@ func resourceBaiduCloudScs() *schema.Resource {
 				Description: "Memory capacity(GB) of the instance to be used.",
 				Computed:    true,
 			},
+			"memory_usage_ratio": {
+				Type:        schema.TypeFloat,
+				Description: "Ratio of used_capacity to capacity rounded to two decimals, such as 0.25. It is 0 if the capacity is unknown.",
+				Computed:    true,
+			},
+			"max_connections": {
+				Type:        schema.TypeInt,
+				Description: "Maximum client connections of each node of the node_type according to the spec list, which can be compared with the connection metrics for capacity alerts. It is 0 if the node_type is not found in the spec list.",
+				Computed:    true,
+			},
 			"payment_timing": {
 				Type:        schema.TypeString,
 				Description: "SCS payment timing",
@@ -267,10 +471,59 @@ func resourceBaiduCloudScs() *schema.Resource {
 					Type: schema.TypeString,
 				},
 			},
+			"raw_detail_json": {
+				Type:        schema.TypeString,
+				Description: "The instance detail returned by the api in JSON, to be attached to bug reports. It is only populated when the debug argument of the provider is true, otherwise it is empty.",
+				Computed:    true,
+			},
 		},
 	}
 }
 
+func resourceBaiduCloudScsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	// the values may be unknown until apply if they reference other resources
+	if d.NewValueKnown("cluster_type") && d.NewValueKnown("shard_num") {
+		if err := checkScsShardNum(d.Get("cluster_type").(string), d.Get("shard_num").(int)); err != nil {
+			return err
+		}
+	}
+
+	// without subnets the instance is placed in a subnet chosen by scs, require them so that the placement is explicit
+	if (d.Id() == "" || d.HasChange("vpc_id") || d.HasChange("vpc_name")) && d.NewValueKnown("subnets") {
+		vpc := d.Get("vpc_id").(string)
+		if vpc == "" {
+			vpc = d.Get("vpc_name").(string)
+		}
+		if vpc != "" && len(d.Get("subnets").([]interface{})) == 0 {
+			return fmt.Errorf("at least one subnet must be set in subnets when the vpc %s is specified", vpc)
+		}
+	}
+
+	if mode, ok := d.GetOk("persistence_mode"); ok && mode.(string) != "" {
+		for _, v := range d.Get("parameters").(*schema.Set).List() {
+			if name := v.(map[string]interface{})["name"].(string); stringInSlice(ScsPersistenceParameterNames, name) {
+				return fmt.Errorf("parameter %s is managed by persistence_mode and can not be set in parameters", name)
+			}
+		}
+	}
+
+	if os.Getenv(ScsSkipNodeTypeCheckEnv) == "" && d.HasChange("node_type") &&
+		d.NewValueKnown("node_type") && d.NewValueKnown("cluster_type") {
+		if nodeType := d.Get("node_type").(string); nodeType != "" {
+			scsService := ScsService{meta.(*connectivity.BaiduClient)}
+			specs, err := scsService.GetNodeTypeList()
+			if err != nil {
+				log.Printf("[WARN] skip checking node_type %s, failed to get the spec list: %v", nodeType, err)
+				return nil
+			}
+			if err := checkScsNodeType(d.Get("cluster_type").(string), nodeType, specs); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func resourceBaiduCloudScsCreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
 	scsService := ScsService{client}
@@ -279,28 +532,35 @@ func resourceBaiduCloudScsCreate(d *schema.ResourceData, meta interface{}) error
 	if err != nil {
 		return WrapError(err)
 	}
+	// node_type may be chosen by capacity
+	d.Set("node_type", createScsArgs.NodeType)
 
 	action := "Create SCS Instance " + createScsArgs.InstanceName
 	addDebug(action, createScsArgs)
 
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+	instanceID := ""
+	if d.Get("adopt_existing").(bool) {
+		instanceID, err = scsService.GetInstanceIdByName(createScsArgs.InstanceName)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+		if instanceID != "" {
+			log.Printf("[INFO] adopt the existing SCS instance %s named %s", instanceID, createScsArgs.InstanceName)
+		}
+	}
+
+	if instanceID == "" {
+		raw, err := scsService.WithScsClientBackoff(d.Timeout(schema.TimeoutCreate), func(scsClient *scs.Client) (interface{}, error) {
 			return scsClient.CreateInstance(createScsArgs)
 		})
 		if err != nil {
-			if IsExceptedErrors(err, []string{bce.EINTERNAL_ERROR}) {
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
 		}
 		addDebug(action, raw)
 		response, _ := raw.(*scs.CreateInstanceResult)
-		d.SetId(response.InstanceIds[0])
-		return nil
-	})
-	if err != nil {
-		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		instanceID = response.InstanceIds[0]
 	}
+	d.SetId(instanceID)
 
 	stateConf := buildStateConf(
 		[]string{SCSStatusStatusCreating},
@@ -317,22 +577,99 @@ func resourceBaiduCloudScsCreate(d *schema.ResourceData, meta interface{}) error
 			SCSStatusStatusExpire,
 		}),
 	)
+	stateConf.Delay = ScsCreatePollDelay
+	if interval := d.Get("create_poll_interval").(int); interval > 0 {
+		stateConf.PollInterval = time.Duration(interval) * time.Second
+	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
 	}
 
+	// the create api does not accept a domain, so rename it after the instance is running
+	if err := updateScsDomainPrefix(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	// the create api does not accept a password, so set it after the instance is running
+	if err := updateScsPassword(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	if err := updateScsParameters(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	if err := updateScsPersistenceMode(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	if err := updateScsBackupPolicy(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	if err := updateScsSecurityGroups(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	if err := updateScsTags(d, meta, d.Id()); err != nil {
+		return err
+	}
+
+	// the token is only needed until the instance is created, do not persist it in the state
+	d.Set("client_token", "")
+
 	return resourceBaiduCloudScsRead(d, meta)
 }
 
+func resourceBaiduCloudScsImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	instanceID := d.Id()
+	action := "Import SCS Instance " + instanceID
+
+	result, err := scsService.GetInstanceDetail(instanceID)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	// billing and purchase_count are only used when creating, rebuild them so that the imported instance has no diff
+	d.Set("billing", map[string]interface{}{
+		"payment_timing": result.PaymentTiming,
+	})
+	d.Set("purchase_count", 1)
+	d.Set("allow_shrink", false)
+	d.Set("delete_on_isolation", false)
+	d.Set("adopt_existing", false)
+	d.Set("renew_time_length", 1)
+	d.Set("create_poll_interval", 0)
+	d.Set("delete_poll_interval", 0)
+	if password := os.Getenv(ScsImportPasswordEnv); password != "" {
+		d.Set("password", password)
+	}
+
+	if err := resourceBaiduCloudScsRead(d, meta); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceBaiduCloudScsRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
 
 	instanceID := d.Id()
 	action := "Query SCS Instance " + instanceID
 
-	raw, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+	// a new instance may not be found right after it is created, retry before clearing the id
+	readTimeout := time.Duration(0)
+	if d.IsNewResource() {
+		readTimeout = ScsReadAfterCreateTimeout
+	}
+	raw, err := scsService.WithScsClientBackoff(readTimeout, func(scsClient *scs.Client) (interface{}, error) {
 		return scsClient.GetInstanceDetail(instanceID)
-	})
+	}, NotFoundErrorList...)
 
 	addDebug(action, raw)
 
@@ -350,22 +687,59 @@ func resourceBaiduCloudScsRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("instance_name", result.InstanceName)
 	d.Set("cluster_type", result.ClusterType)
 	d.Set("instance_status", result.InstanceStatus)
+	isolated := strings.EqualFold(result.InstanceStatus, SCSSTatusStatusIsolated)
+	d.Set("is_isolated", isolated)
+	if isolated {
+		if d.Get("delete_on_isolation").(bool) {
+			log.Printf("[WARN] SCS instance %s is isolated since %s, it is released from the recycle bin when destroyed", instanceID, result.InstanceExpireTime)
+		} else {
+			log.Printf("[WARN] SCS instance %s is isolated since %s, renew it before it is released", instanceID, result.InstanceExpireTime)
+		}
+	}
 	d.Set("engine", result.Engine)
 	d.Set("engine_version", result.EngineVersion)
 	d.Set("v_net_ip", result.VnetIP)
 	d.Set("domain", result.Domain)
+	d.Set("domain_prefix", strings.SplitN(result.Domain, ".", 2)[0])
 	d.Set("port", result.Port)
 	d.Set("create_time", result.InstanceCreateTime)
 	d.Set("expire_time", result.InstanceExpireTime)
 	d.Set("capacity", result.Capacity)
 
 	d.Set("used_capacity", result.UsedCapacity)
+	d.Set("memory_usage_ratio", scsMemoryUsageRatio(result.UsedCapacity, result.Capacity))
 	d.Set("payment_timing", result.PaymentTiming)
 	d.Set("zone_names", result.ZoneNames)
 	d.Set("vpc_id", result.VpcID)
 	d.Set("subnets", transSubnetsToSchema(result.Subnets))
-	d.Set("auto_renew", result.AutoRenew)
-	d.Set("tags", flattenTagsToMap(result.Tags))
+	// the api returns auto renew as a string, it must be read back so that the update guard of auto_renew works
+	if err := d.Set("auto_renew", scsAutoRenew(result.AutoRenew)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+	tags := flattenTagsToMap(client, result.Tags)
+	d.Set("description", tags[ScsDescriptionTagKey])
+	delete(tags, ScsDescriptionTagKey)
+	d.Set("tags", tags)
+
+	rawDetail := ""
+	if client.Debug() {
+		if data, err := json.Marshal(connectivity.RedactDebugContent(result)); err == nil {
+			rawDetail = string(data)
+		}
+	}
+	d.Set("raw_detail_json", rawDetail)
+
+	if err := readScsMaxConnections(d, meta); err != nil {
+		return err
+	}
+
+	if err := readScsParameters(d, meta, instanceID); err != nil {
+		return err
+	}
+
+	if err := readScsSecurityGroups(d, meta, instanceID); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -384,6 +758,37 @@ func transSubnetsToSchema(subnets []scs.Subnet) []map[string]string {
 func resourceBaiduCloudScsUpdate(d *schema.ResourceData, meta interface{}) error {
 	instanceID := d.Id()
 
+	// the scs api does not support modifying auto renewal of an existing instance
+	if d.HasChange("auto_renew") || d.HasChange("auto_renew_time_unit") || d.HasChange("auto_renew_time_length") {
+		return WrapErrorf(Error("auto_renew, auto_renew_time_unit and auto_renew_time_length can not be modified after the instance is created"),
+			DefaultErrorMsg, "baiducloud_scs", "Update SCS auto renew "+instanceID, BCESDKGoERROR)
+	}
+
+	// the scs api has no call to convert the payment timing of an existing instance
+	if d.HasChange("billing") {
+		o, n := d.GetChange("billing")
+		oldTiming := o.(map[string]interface{})["payment_timing"]
+		newTiming := n.(map[string]interface{})["payment_timing"]
+		if oldTiming != newTiming {
+			return WrapErrorf(Error("billing.payment_timing can not be changed from %v to %v after the instance is created, "+
+				"converting between Postpaid and Prepaid is not supported, please recreate the instance instead", oldTiming, newTiming),
+				DefaultErrorMsg, "baiducloud_scs", "Update SCS billing "+instanceID, BCESDKGoERROR)
+		}
+	}
+
+	// decreasing shard_num may lose data, so it must be allowed explicitly
+	if d.HasChange("shard_num") && !d.Get("allow_shrink").(bool) {
+		o, n := d.GetChange("shard_num")
+		if n.(int) < o.(int) {
+			return WrapErrorf(Error("decreasing shard_num from %d to %d may lose data if the remaining shards can not hold it, "+
+				"set allow_shrink to true to confirm the change", o.(int), n.(int)),
+				DefaultErrorMsg, "baiducloud_scs", "Update scs shardNum "+instanceID, BCESDKGoERROR)
+		}
+	}
+
+	scsInstanceMutex.Lock(instanceID)
+	defer scsInstanceMutex.Unlock(instanceID)
+
 	d.Partial(true)
 
 	// update instance name
@@ -391,8 +796,29 @@ func resourceBaiduCloudScsUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
-	// update instance nodeType
-	if err := updateInstanceNodeType(d, meta, instanceID); err != nil {
+	// update instance domain
+	if err := updateScsDomainPrefix(d, meta, instanceID); err != nil {
+		return err
+	}
+
+	// a larger capacity is resized by choosing a larger nodeType, node_type is not in the configuration then,
+	// so the resolved nodeType is never seen as a change of node_type and must be passed explicitly
+	nodeType := ""
+	if d.HasChange("node_type") {
+		nodeType = d.Get("node_type").(string)
+	}
+	if d.HasChange("capacity") {
+		resolved, err := resolveScsNodeType(meta, d.Get("cluster_type").(string), d.Get("shard_num").(int), d.Get("capacity").(int))
+		if err != nil {
+			return err
+		}
+		if oldNodeType, _ := d.GetChange("node_type"); resolved != oldNodeType.(string) {
+			nodeType = resolved
+		}
+	}
+
+	// update instance nodeType, it must be done before updating shardNum
+	if err := updateInstanceNodeType(d, meta, instanceID, nodeType); err != nil {
 		return err
 	}
 
@@ -401,6 +827,41 @@ func resourceBaiduCloudScsUpdate(d *schema.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// update instance parameters
+	if err := updateScsParameters(d, meta, instanceID); err != nil {
+		return err
+	}
+
+	// update instance persistence mode
+	if err := updateScsPersistenceMode(d, meta, instanceID); err != nil {
+		return err
+	}
+
+	// update instance password
+	if err := updateScsPassword(d, meta, instanceID); err != nil {
+		return err
+	}
+
+	// update instance backup policy
+	if err := updateScsBackupPolicy(d, meta, instanceID); err != nil {
+		return err
+	}
+
+	// update instance security groups
+	if err := updateScsSecurityGroups(d, meta, instanceID); err != nil {
+		return err
+	}
+
+	// update instance tags
+	if err := updateScsTags(d, meta, instanceID); err != nil {
+		return err
+	}
+
+	// renew the instance
+	if err := renewScsInstance(d, meta, instanceID); err != nil {
+		return err
+	}
+
 	d.Partial(false)
 
 	return resourceBaiduCloudScsRead(d, meta)
@@ -413,19 +874,23 @@ func resourceBaiduCloudScsDelete(d *schema.ResourceData, meta interface{}) error
 	instanceId := d.Id()
 	action := "Delete SCS Instance " + instanceId
 
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		raw, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
-			return instanceId, scsClient.DeleteInstance(instanceId, buildClientToken())
-		})
-		if err != nil {
-			if IsExceptedErrors(err, []string{InvalidInstanceStatus, bce.EINTERNAL_ERROR, ReleaseInstanceFailed}) {
-				return resource.RetryableError(err)
+	scsInstanceMutex.Lock(instanceId)
+	defer scsInstanceMutex.Unlock(instanceId)
+
+	raw, err := scsService.WithScsClientBackoff(d.Timeout(schema.TimeoutDelete), func(scsClient *scs.Client) (interface{}, error) {
+		err := scsClient.DeleteInstance(instanceId, buildClientToken())
+		if err != nil && IsExceptedErrors(err, []string{InvalidInstanceStatus, ReleaseInstanceFailed}) {
+			// a previous or concurrent apply may have deleted the instance already, wait for it instead of failing
+			detail, e := scsClient.GetInstanceDetail(instanceId)
+			if e == nil && stringInSlice([]string{SCSStatusStatusDeleting, SCSStatusStatusDeleted, SCSSTatusStatusIsolated},
+				detail.InstanceStatus) {
+				log.Printf("[DEBUG] scs instance %s is already %s, skip deleting", instanceId, detail.InstanceStatus)
+				return instanceId, nil
 			}
-			return resource.NonRetryableError(err)
 		}
-		addDebug(action, raw)
-		return nil
-	})
+		return instanceId, err
+	}, ReleaseInstanceFailed)
+	addDebug(action, raw)
 	if err != nil {
 		if IsExceptedErrors(err, []string{InvalidInstanceStatus, InstanceNotExist, bce.EINTERNAL_ERROR}) {
 			return nil
@@ -441,47 +906,102 @@ func resourceBaiduCloudScsDelete(d *schema.ResourceData, meta interface{}) error
 			SCSStatusStatusDeleted,
 			SCSSTatusStatusIsolated},
 		d.Timeout(schema.TimeoutDelete),
-		scsService.InstanceStateRefresh(instanceId, []string{}),
+		scsService.InstanceDeleteStateRefresh(instanceId),
 	)
+	if interval := d.Get("delete_poll_interval").(int); interval > 0 {
+		stateConf.Delay = time.Duration(interval) * time.Second
+		stateConf.PollInterval = time.Duration(interval) * time.Second
+	}
 	if _, err := stateConf.WaitForState(); err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
 	}
 
+	if d.Get("delete_on_isolation").(bool) {
+		return releaseScsRecycleInstance(d, meta, instanceId)
+	}
+
 	return nil
 }
 
-func buildBaiduCloudScsArgs(d *schema.ResourceData, meta interface{}) (*scs.CreateInstanceArgs, error) {
-	request := &scs.CreateInstanceArgs{
-		ClientToken: buildClientToken(),
-	}
+// releaseScsRecycleInstance releases the isolated instance from the recycle bin and waits until it is gone
+func releaseScsRecycleInstance(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Release SCS Instance " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
 
-	if v, ok := d.GetOk("billing"); ok {
-		billing := v.(map[string]interface{})
-		billingRequest := scs.Billing{
-			PaymentTiming: "",
-			Reservation:   &scs.Reservation{},
-		}
-		if p, ok := billing["payment_timing"]; ok {
-			paymentTiming := p.(string)
-			billingRequest.PaymentTiming = paymentTiming
-		}
-		if billingRequest.PaymentTiming == PaymentTimingPostpaid {
-			if r, ok := billing["reservation"]; ok {
-				reservation := r.(map[string]interface{})
-				if reservationLength, ok := reservation["reservation_length"]; ok {
-					billingRequest.Reservation.ReservationLength = reservationLength.(int)
-				}
-				if reservationTimeUnit, ok := reservation["reservation_time_unit"]; ok {
-					billingRequest.Reservation.ReservationTimeUnit = reservationTimeUnit.(string)
-				}
+	inRecycleBin := func() (bool, error) {
+		instances, err := scsService.ListAllRecycleInstances()
+		if err != nil {
+			return false, err
+		}
+		for _, instance := range instances {
+			if instance.InstanceID == instanceID {
+				return true, nil
 			}
-			// if the field is set, then auto-renewal is effective.
-			if v, ok := d.GetOk("auto_renew_time_unit"); ok {
-				request.AutoRenewTimeUnit = v.(string)
+		}
+		return false, nil
+	}
+
+	found, err := inRecycleBin()
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+	if !found {
+		log.Printf("[DEBUG] scs instance %s is not in the recycle bin, skip releasing", instanceID)
+		return nil
+	}
+
+	_, err = scsService.WithScsClientBackoff(d.Timeout(schema.TimeoutDelete), func(scsClient *scs.Client) (interface{}, error) {
+		return nil, scsClient.DeleteRecyclerInstances([]string{instanceID})
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	stateConf := buildStateConf(
+		[]string{"Releasing"},
+		[]string{"Released"},
+		d.Timeout(schema.TimeoutDelete),
+		func() (interface{}, string, error) {
+			found, err := inRecycleBin()
+			if err != nil {
+				return nil, "", WrapError(err)
+			}
+			if found {
+				return instanceID, "Releasing", nil
+			}
+			return instanceID, "Released", nil
+		},
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
 
-				if v, ok := d.GetOk("auto_renew_time_length"); ok {
-					request.AutoRenewTime = v.(int)
-				}
+	return nil
+}
+
+func buildBaiduCloudScsArgs(d *schema.ResourceData, meta interface{}) (*scs.CreateInstanceArgs, error) {
+	request := &scs.CreateInstanceArgs{
+		ClientToken: buildClientToken(),
+	}
+	if v, ok := d.GetOk("client_token"); ok {
+		request.ClientToken = v.(string)
+	}
+
+	if v, ok := d.GetOk("billing"); ok {
+		billingRequest := buildScsBilling(v.(map[string]interface{}))
+		// auto-renewal is only effective for Prepaid instances
+		if billingRequest.PaymentTiming == PaymentTimingPrepai && d.Get("auto_renew").(bool) {
+			request.AutoRenewTimeUnit = "month"
+			if v, ok := d.GetOk("auto_renew_time_unit"); ok {
+				request.AutoRenewTimeUnit = v.(string)
+			}
+			request.AutoRenewTime = 1
+			if v, ok := d.GetOk("auto_renew_time_length"); ok {
+				request.AutoRenewTime = v.(int)
+			}
+			if request.AutoRenewTimeUnit == "year" && request.AutoRenewTime > 3 {
+				return nil, fmt.Errorf("auto_renew_time_length should be 1-3 when auto_renew_time_unit is year, got %d", request.AutoRenewTime)
 			}
 		}
 
@@ -528,6 +1048,15 @@ func buildBaiduCloudScsArgs(d *schema.ResourceData, meta interface{}) (*scs.Crea
 		request.VpcID = vpcID.(string)
 	}
 
+	if vpcName, ok := d.GetOk("vpc_name"); ok {
+		vpcService := VpcService{meta.(*connectivity.BaiduClient)}
+		vpcID, err := vpcService.GetVpcIdByName(vpcName.(string))
+		if err != nil {
+			return nil, err
+		}
+		request.VpcID = vpcID
+	}
+
 	if v, ok := d.GetOk("subnets"); ok {
 		subnetList := v.([]interface{})
 		subnetRequests := make([]scs.Subnet, len(subnetList))
@@ -541,35 +1070,104 @@ func buildBaiduCloudScsArgs(d *schema.ResourceData, meta interface{}) (*scs.Crea
 
 			subnetRequests[id] = cdsRequest
 		}
+		if err := checkScsSubnets(request.ReplicationNum, subnetRequests); err != nil {
+			return nil, WrapError(err)
+		}
+		if request.VpcID != "" {
+			if err := checkScsSubnetsInVpc(meta, request.VpcID, subnetRequests); err != nil {
+				return nil, err
+			}
+		}
 		request.Subnets = subnetRequests
 	}
 
+	if request.NodeType == "" {
+		capacity, ok := d.GetOk("capacity")
+		if !ok {
+			return nil, WrapError(Error("one of node_type and capacity must be set"))
+		}
+		nodeType, err := resolveScsNodeType(meta, request.ClusterType, request.ShardNum, capacity.(int))
+		if err != nil {
+			return nil, err
+		}
+		request.NodeType = nodeType
+	}
+
 	return request, nil
 
 }
 
+// buildScsBilling builds the billing of the create request, the reservation only applies to Prepaid instances
+// and defaults to 1 month
+func buildScsBilling(billing map[string]interface{}) scs.Billing {
+	billingRequest := scs.Billing{
+		PaymentTiming: "",
+		Reservation:   &scs.Reservation{},
+	}
+	if p, ok := billing["payment_timing"]; ok {
+		paymentTiming := p.(string)
+		billingRequest.PaymentTiming = paymentTiming
+	}
+	if billingRequest.PaymentTiming == PaymentTimingPrepai {
+		billingRequest.Reservation.ReservationLength = 1
+		billingRequest.Reservation.ReservationTimeUnit = "Month"
+		if r, ok := billing["reservation"].(map[string]interface{}); ok {
+			if reservationLength, ok := r["reservation_length"]; ok {
+				billingRequest.Reservation.ReservationLength = reservationLength.(int)
+			}
+			if reservationTimeUnit, ok := r["reservation_time_unit"]; ok {
+				billingRequest.Reservation.ReservationTimeUnit = reservationTimeUnit.(string)
+			}
+		}
+	}
+	return billingRequest
+}
+
+// checkScsSubnetsInVpc checks every subnet belongs to the vpc, the api only rejects the mismatch
+// after a long wait with an obscure error
+func checkScsSubnetsInVpc(meta interface{}, vpcID string, subnets []scs.Subnet) error {
+	vpcService := VpcService{meta.(*connectivity.BaiduClient)}
+	for _, subnet := range subnets {
+		if subnet.SubnetID == "" {
+			continue
+		}
+		detail, err := vpcService.GetSubnetDetail(subnet.SubnetID)
+		if err != nil {
+			return err
+		}
+		if detail.Subnet.VPCId != vpcID {
+			return WrapError(Error("subnet %s belongs to vpc %s, but the instance is created in vpc %s",
+				subnet.SubnetID, detail.Subnet.VPCId, vpcID))
+		}
+	}
+	return nil
+}
+
+// scsModifyTimeout returns the timeout of modifying the instance, the modifications applied right after creating the
+// instance are limited by the create timeout of the resource, and the others by the update timeout
+func scsModifyTimeout(d *schema.ResourceData) time.Duration {
+	if d.IsNewResource() {
+		return d.Timeout(schema.TimeoutCreate)
+	}
+	return d.Timeout(schema.TimeoutUpdate)
+}
+
 func updateScsInstanceName(d *schema.ResourceData, meta interface{}, instanceID string) error {
 	action := "Update scs instanceName " + instanceID
 	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
 
-	if d.HasChange("instance_name") {
+	o, n := d.GetChange("instance_name")
+	oldName, newName := strings.TrimSpace(o.(string)), strings.TrimSpace(n.(string))
+	if oldName != newName {
 		args := &scs.UpdateInstanceNameArgs{
-			InstanceName: d.Get("instance_name").(string),
+			InstanceName: newName,
 			ClientToken:  buildClientToken(),
 		}
 
 		addDebug(action, args)
-		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			_, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
-				return nil, scsClient.UpdateInstanceName(instanceID, args)
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.UpdateInstanceName(instanceID, args)
 		})
 
 		if err != nil {
@@ -581,28 +1179,61 @@ func updateScsInstanceName(d *schema.ResourceData, meta interface{}, instanceID
 	return nil
 }
 
-func updateInstanceNodeType(d *schema.ResourceData, meta interface{}, instanceID string) error {
+func updateScsDomainPrefix(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update scs domain " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	if d.HasChange("domain_prefix") && d.Get("domain_prefix").(string) != "" {
+		args := &scs.UpdateInstanceDomainNameArgs{
+			Domain:      d.Get("domain_prefix").(string),
+			ClientToken: buildClientToken(),
+		}
+
+		addDebug(action, args)
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.UpdateInstanceDomainName(instanceID, args)
+		})
+
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+
+		stateConf := buildStateConf(
+			[]string{SCSStatusStatusModifying},
+			[]string{SCSStatusStatusRunning},
+			scsModifyTimeout(d),
+			scsService.InstanceStateRefresh(instanceID, []string{}),
+		)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+		d.SetPartial("domain_prefix")
+	}
+
+	return nil
+}
+
+// updateInstanceNodeType resizes the instance to nodeType, it does nothing if nodeType is empty
+func updateInstanceNodeType(d *schema.ResourceData, meta interface{}, instanceID, nodeType string) error {
 	action := "Update scs nodeType " + instanceID
 	client := meta.(*connectivity.BaiduClient)
 	scsService := ScsService{client}
 
-	if d.HasChange("node_type") && "master_slave" == d.Get("cluster_type").(string) {
+	if nodeType != "" {
 		args := &scs.ResizeInstanceArgs{
-			NodeType: d.Get("node_type").(string),
+			NodeType: nodeType,
+		}
+		// resize the node type of every shard with the current shard number, shard_num is resized
+		// by updateInstanceShardNum afterwards, so that only one resize runs at a time
+		if "cluster" == d.Get("cluster_type").(string) {
+			oldShardNum, _ := d.GetChange("shard_num")
+			args.ShardNum = oldShardNum.(int)
 		}
 
 		addDebug(action, args)
-		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			_, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
-				return nil, scsClient.ResizeInstance(instanceID, args)
-			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.ResizeInstance(instanceID, args)
 		})
 
 		if err != nil {
@@ -612,14 +1243,16 @@ func updateInstanceNodeType(d *schema.ResourceData, meta interface{}, instanceID
 		stateConf := buildStateConf(
 			[]string{SCSStatusStatusModifying},
 			[]string{SCSStatusStatusRunning},
-			d.Timeout(schema.TimeoutUpdate),
-			scsService.InstanceStateRefresh(d.Id(), []string{}),
+			scsModifyTimeout(d),
+			scsService.InstanceStateRefresh(d.Id(), []string{SCSStatusStatusModifyfailed}),
 		)
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
 		}
 
+		d.Set("node_type", nodeType)
 		d.SetPartial("node_type")
+		d.SetPartial("capacity")
 	}
 
 	return nil
@@ -636,17 +1269,184 @@ func updateInstanceShardNum(d *schema.ResourceData, meta interface{}, instanceID
 		}
 
 		addDebug(action, args)
-		err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			_, err := client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
-				return nil, scsClient.ResizeInstance(instanceID, args)
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.ResizeInstance(instanceID, args)
+		})
+
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+
+		stateConf := buildStateConf(
+			[]string{SCSStatusStatusModifying},
+			[]string{SCSStatusStatusRunning},
+			scsModifyTimeout(d),
+			scsService.InstanceStateRefresh(d.Id(), []string{SCSStatusStatusModifyfailed}),
+		)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+		d.SetPartial("shard_num")
+	}
+
+	return nil
+}
+
+func updateScsPassword(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update scs password " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	if d.HasChange("password") {
+		password := d.Get("password").(string)
+		if password == "" {
+			// the api can only modify the password, it can not disable the auth of an instance
+			return WrapErrorf(Error("password of the instance can not be removed once it has been set"),
+				DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			// ModifyPassword encrypts the password in args, so build a new args for every retry
+			return nil, scsClient.ModifyPassword(instanceID, &scs.ModifyPasswordArgs{
+				Password:    password,
+				ClientToken: buildClientToken(),
 			})
-			if err != nil {
-				if IsExceptedErrors(err, []string{InvalidInstanceStatus, OperationException, bce.EINTERNAL_ERROR}) {
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
+		})
+
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+
+		stateConf := buildStateConf(
+			[]string{SCSStatusStatusModifying},
+			[]string{SCSStatusStatusRunning},
+			scsModifyTimeout(d),
+			scsService.InstanceStateRefresh(instanceID, []string{}),
+		)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+
+		d.SetPartial("password")
+	}
+
+	return nil
+}
+
+func readScsMaxConnections(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	nodeType := d.Get("node_type").(string)
+	if nodeType == "" {
+		return nil
+	}
+
+	// the connection limit is informational, do not fail reading the instance if the spec list is unavailable
+	result, err := scsService.GetNodeTypeList()
+	if err != nil {
+		log.Printf("[WARN] skip reading max_connections of node_type %s, failed to get the spec list: %v", nodeType, err)
+		return nil
+	}
+
+	return d.Set("max_connections", scsMaxConnections(d.Get("cluster_type").(string), nodeType, result))
+}
+
+func readScsParameters(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Query scs parameters " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	result, err := scsService.GetParameters(instanceID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	values := make(map[string]string, len(result.Parameters))
+	for _, parameter := range result.Parameters {
+		values[parameter.Name] = parameter.Value
+	}
+
+	if mode := scsPersistenceMode(values); mode != "" {
+		d.Set("persistence_mode", mode)
+	}
+
+	// only the parameters managed by terraform are saved, otherwise all the default values will be seen as drift
+	managed := d.Get("parameters").(*schema.Set).List()
+	if len(managed) == 0 {
+		return nil
+	}
+
+	parameters := make([]map[string]interface{}, 0, len(managed))
+	for _, m := range managed {
+		name := m.(map[string]interface{})["name"].(string)
+		if value, ok := values[name]; ok {
+			parameters = append(parameters, map[string]interface{}{
+				"name":  name,
+				"value": value,
+			})
+		}
+	}
+
+	return d.Set("parameters", parameters)
+}
+
+func updateScsParameters(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update scs parameters " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	if !d.HasChange("parameters") {
+		return nil
+	}
+
+	o, n := d.GetChange("parameters")
+	oldParameters := make(map[string]string)
+	for _, v := range o.(*schema.Set).List() {
+		parameter := v.(map[string]interface{})
+		oldParameters[parameter["name"].(string)] = parameter["value"].(string)
+	}
+	newParameters := make(map[string]string)
+	for _, v := range n.(*schema.Set).List() {
+		parameter := v.(map[string]interface{})
+		newParameters[parameter["name"].(string)] = parameter["value"].(string)
+	}
+
+	changed := make([]scs.InstanceParam, 0)
+	for name, value := range newParameters {
+		if oldValue, ok := oldParameters[name]; !ok || oldValue != value {
+			changed = append(changed, scs.InstanceParam{Name: name, Value: value})
+		}
+	}
+
+	// parameters removed from the configuration are restored to their default values
+	removed := make([]string, 0)
+	for name := range oldParameters {
+		if _, ok := newParameters[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+	if len(removed) > 0 {
+		result, err := scsService.GetParameters(instanceID)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+		for _, parameter := range result.Parameters {
+			if stringInSlice(removed, parameter.Name) && parameter.Value != parameter.Default {
+				changed = append(changed, scs.InstanceParam{Name: parameter.Name, Value: parameter.Default})
 			}
-			return nil
+		}
+	}
+
+	for _, parameter := range changed {
+		args := &scs.ModifyParametersArgs{
+			Parameter:   parameter,
+			ClientToken: buildClientToken(),
+		}
+
+		addDebug(action, args)
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.ModifyParameters(instanceID, args)
 		})
 
 		if err != nil {
@@ -656,14 +1456,324 @@ func updateInstanceShardNum(d *schema.ResourceData, meta interface{}, instanceID
 		stateConf := buildStateConf(
 			[]string{SCSStatusStatusModifying},
 			[]string{SCSStatusStatusRunning},
-			d.Timeout(schema.TimeoutCreate),
-			scsService.InstanceStateRefresh(d.Id(), []string{}),
+			scsModifyTimeout(d),
+			scsService.InstanceStateRefresh(instanceID, []string{}),
 		)
 		if _, err := stateConf.WaitForState(); err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
 		}
-		d.SetPartial("shard_num")
 	}
 
+	d.SetPartial("parameters")
+
 	return nil
 }
+
+func updateScsPersistenceMode(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update scs persistence mode " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	mode := d.Get("persistence_mode").(string)
+	if !d.HasChange("persistence_mode") || mode == "" {
+		return nil
+	}
+
+	result, err := scsService.GetParameters(instanceID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+	current := make(map[string]string, len(result.Parameters))
+	for _, parameter := range result.Parameters {
+		current[parameter.Name] = parameter.Value
+	}
+
+	for name, value := range scsPersistenceParameters(mode) {
+		if oldValue, ok := current[name]; ok && oldValue == value {
+			continue
+		}
+
+		args := &scs.ModifyParametersArgs{
+			Parameter:   scs.InstanceParam{Name: name, Value: value},
+			ClientToken: buildClientToken(),
+		}
+
+		addDebug(action, args)
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.ModifyParameters(instanceID, args)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+
+		stateConf := buildStateConf(
+			[]string{SCSStatusStatusModifying},
+			[]string{SCSStatusStatusRunning},
+			scsModifyTimeout(d),
+			scsService.InstanceStateRefresh(instanceID, []string{}),
+		)
+		if _, err := stateConf.WaitForState(); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+	}
+
+	d.SetPartial("persistence_mode")
+
+	return nil
+}
+
+func updateScsBackupPolicy(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update scs backup policy " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	// removing the block does not disable the backup, the current policy of the instance is kept
+	if !d.HasChange("backup_config") || len(d.Get("backup_config").([]interface{})) == 0 {
+		return nil
+	}
+
+	backupConfig := d.Get("backup_config").([]interface{})[0].(map[string]interface{})
+	args := &scs.ModifyBackupPolicyArgs{
+		BackupDays:  backupConfig["backup_days"].(string),
+		BackupTime:  backupConfig["backup_time"].(string),
+		ExpireDay:   backupConfig["expire_day"].(int),
+		ClientToken: buildClientToken(),
+	}
+
+	addDebug(action, args)
+	_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+		return nil, scsClient.ModifyBackupPolicy(instanceID, args)
+	})
+
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	d.SetPartial("backup_config")
+
+	return nil
+}
+
+func readScsSecurityGroups(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Query scs security groups " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	result, err := scsService.ListSecurityGroupByInstanceId(instanceID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	securityGroupIds := make([]string, 0, len(result.Groups))
+	for _, group := range result.Groups {
+		securityGroupIds = append(securityGroupIds, group.SecurityGroupID)
+	}
+	d.Set("security_group_ids", securityGroupIds)
+
+	return nil
+}
+
+func updateScsSecurityGroups(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update scs security groups " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	if !d.HasChange("security_group_ids") {
+		return nil
+	}
+
+	o, n := d.GetChange("security_group_ids")
+	bindIds := expandStringSet(n.(*schema.Set).Difference(o.(*schema.Set)))
+	unbindIds := expandStringSet(o.(*schema.Set).Difference(n.(*schema.Set)))
+
+	if len(bindIds) > 0 {
+		args := &scs.SecurityGroupArgs{
+			InstanceIds:      []string{instanceID},
+			SecurityGroupIds: bindIds,
+		}
+		addDebug(action, args)
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.BindSecurityGroups(args)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+	}
+
+	if len(unbindIds) > 0 {
+		args := &scs.UnbindSecurityGroupArgs{
+			InstanceId:       instanceID,
+			SecurityGroupIds: unbindIds,
+		}
+		addDebug(action, args)
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.UnBindSecurityGroups(args)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+	}
+
+	d.SetPartial("security_group_ids")
+
+	return nil
+}
+
+// scsTagsWithDescription returns a copy of the tags with the description stored under ScsDescriptionTagKey
+func scsTagsWithDescription(tags map[string]interface{}, description string) map[string]interface{} {
+	result := make(map[string]interface{}, len(tags)+1)
+	for key, value := range tags {
+		result[key] = value
+	}
+	if description != "" {
+		result[ScsDescriptionTagKey] = description
+	}
+	return result
+}
+
+func updateScsTags(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update scs tags " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	if !d.HasChange("tags") && !d.HasChange("description") {
+		return nil
+	}
+
+	// description is bound as a tag too
+	o, n := d.GetChange("tags")
+	oldDescription, newDescription := d.GetChange("description")
+	oldTags := scsTagsWithDescription(o.(map[string]interface{}), oldDescription.(string))
+	newTags := scsTagsWithDescription(n.(map[string]interface{}), newDescription.(string))
+
+	unbindTags, bindTags := diffScsTags(client, oldTags, newTags)
+
+	if len(unbindTags) > 0 {
+		args := &scs.BindingTagArgs{
+			ChangeTags: tranceTagMapToModel(unbindTags),
+		}
+		addDebug(action, args)
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.UnBindingTag(instanceID, args)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+	}
+
+	if len(bindTags) > 0 {
+		args := &scs.BindingTagArgs{
+			ChangeTags: tranceTagMapToModel(bindTags),
+		}
+		addDebug(action, args)
+		_, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.BindingTag(instanceID, args)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+	}
+
+	d.SetPartial("tags")
+	d.SetPartial("description")
+
+	return nil
+}
+
+// renewScsInstance renews the Prepaid instance by renew_time_length months when renew_triggers changes,
+// and waits until the expire time of the instance is extended
+func renewScsInstance(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Renew scs instance " + instanceID
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	if !d.HasChange("renew_triggers") {
+		return nil
+	}
+	if d.Get("billing.payment_timing").(string) != PaymentTimingPrepai {
+		return WrapErrorf(Error("only Prepaid instances can be renewed"), DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	detail, err := scsService.GetInstanceDetail(instanceID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+	oldExpireTime := detail.InstanceExpireTime
+
+	args := &scs.RenewInstanceArgs{
+		Duration:    d.Get("renew_time_length").(int),
+		InstanceIds: []string{instanceID},
+	}
+	addDebug(action, args)
+	raw, err := scsService.WithScsClientBackoff(scsModifyTimeout(d), func(scsClient *scs.Client) (interface{}, error) {
+		return scsClient.RenewInstances(args)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+	addDebug(action, raw)
+
+	// the order of renewal is paid asynchronously, wait until the expire time is extended
+	stateConf := buildStateConf(
+		[]string{"Renewing"},
+		[]string{"Renewed"},
+		scsModifyTimeout(d),
+		func() (interface{}, string, error) {
+			result, err := scsService.GetInstanceDetailWithTimeout(instanceID, ScsRefreshRequestTimeout)
+			if err != nil {
+				return nil, "", WrapError(err)
+			}
+			if scsExpireTimeAdvanced(oldExpireTime, result.InstanceExpireTime) {
+				return result, "Renewed", nil
+			}
+			return result, "Renewing", nil
+		},
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	d.SetPartial("renew_triggers")
+	d.SetPartial("renew_time_length")
+
+	return nil
+}
+
+// resolveScsNodeType chooses the smallest node type whose total capacity of all the shards is not less than capacity
+func resolveScsNodeType(meta interface{}, clusterType string, shardNum, capacity int) (string, error) {
+	action := "Resolve scs nodeType by capacity"
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	result, err := scsService.GetNodeTypeList()
+	if err != nil {
+		return "", err
+	}
+
+	nodeTypes := result.DefaultNodeTypeList
+	if clusterType == "cluster" {
+		nodeTypes = result.ClusterNodeTypeList
+	}
+	if shardNum < 1 {
+		shardNum = 1
+	}
+	nodeCapacity := (capacity + shardNum - 1) / shardNum
+
+	nodeType := ""
+	minFlavor := 0
+	for _, spec := range nodeTypes {
+		if spec.InstanceFlavor < nodeCapacity {
+			continue
+		}
+		if nodeType == "" || spec.InstanceFlavor < minFlavor {
+			nodeType = spec.NodeType
+			minFlavor = spec.InstanceFlavor
+		}
+	}
+	if nodeType == "" {
+		return "", WrapErrorf(Error("no node_type of %s instance provides %dGB capacity with %d shards", clusterType, capacity, shardNum),
+			DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	return nodeType, nil
+}
@@ -0,0 +1,111 @@
+/*
+Use this resource to flush all the data of a SCS instance. It is a one-shot action, the data is flushed when the resource is created,
+and destroying the resource does nothing.
+
+~> **NOTE:** All the keys of the instance will be removed and can not be recovered, use it with caution.
+
+Example Usage
+
+```hcl
+resource "baiducloud_scs_flush" "default" {
+  instance_id = "scs-bj-xxxxxxxx"
+  password    = "Aa123456"
+
+  triggers = {
+    version = "1"
+  }
+}
+```
+*/
+package baiducloud
+
+import (
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/scs"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudScsFlush() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudScsFlushCreate,
+		Read:   resourceBaiduCloudScsFlushRead,
+		Delete: resourceBaiduCloudScsFlushDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the SCS instance to be flushed.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Description: "Access password of the instance, required if the instance has set a password.",
+				Optional:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary map of values that, when changed, will flush the instance again.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudScsFlushCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	instanceID := d.Get("instance_id").(string)
+	password := d.Get("password").(string)
+	action := "Flush SCS Instance " + instanceID
+
+	scsInstanceMutex.Lock(instanceID)
+	defer scsInstanceMutex.Unlock(instanceID)
+
+	_, err := scsService.WithScsClientBackoff(d.Timeout(schema.TimeoutCreate), func(scsClient *scs.Client) (interface{}, error) {
+		// FlushInstance encrypts the password in args, so build a new args for every retry
+		return nil, scsClient.FlushInstance(instanceID, &scs.FlushInstanceArgs{
+			Password:    password,
+			ClientToken: buildClientToken(),
+		})
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_flush", action, BCESDKGoERROR)
+	}
+
+	stateConf := buildStateConf(
+		[]string{SCSStatusStatusFlushing},
+		[]string{SCSStatusStatusRunning},
+		d.Timeout(schema.TimeoutCreate),
+		scsService.InstanceStateRefresh(instanceID, []string{SCSStatusStatusFlushFailed}),
+	)
+	if _, err := stateConf.WaitForState(); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_flush", action, BCESDKGoERROR)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return resourceBaiduCloudScsFlushRead(d, meta)
+}
+
+func resourceBaiduCloudScsFlushRead(d *schema.ResourceData, meta interface{}) error {
+	// flush is a one-shot action, there is nothing to read
+	return nil
+}
+
+func resourceBaiduCloudScsFlushDelete(d *schema.ResourceData, meta interface{}) error {
+	// the flushed data can not be recovered, just remove the resource from the state
+	return nil
+}
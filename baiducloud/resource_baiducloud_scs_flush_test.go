@@ -0,0 +1,73 @@
+package baiducloud
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccScsFlushResourceType = "baiducloud_scs_flush"
+	testAccScsFlushResourceName = testAccScsFlushResourceType + "." + BaiduCloudTestResourceName
+)
+
+func TestAccBaiduCloudScsFlush(t *testing.T) {
+	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
+	name := BaiduCloudTestResourceTypeNameScs + "-" + timeStamp
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccScsDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsFlushConfig(name, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsFlushResourceName),
+					resource.TestCheckResourceAttr(testAccScsFlushResourceName, "triggers.version", "1"),
+				),
+			},
+			{
+				Config: testAccScsFlushConfig(name, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsFlushResourceName),
+					resource.TestCheckResourceAttr(testAccScsFlushResourceName, "triggers.version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScsFlushConfig(name, version string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_scs" "default" {
+    instance_name           = "%s"
+	billing = {
+    	payment_timing 		= "Postpaid"
+  	}
+    purchase_count 			= 1
+  	port 					= 6379
+	engine_version 			= "3.2"
+	node_type 				= "cache.n1.micro"
+	cluster_type 			= "master_slave"
+	replication_num 		= 1
+	shard_num 				= 1
+	proxy_num 				= 0
+	password 				= "Tf-test-123"
+}
+
+resource "baiducloud_scs_flush" "default" {
+	instance_id = baiducloud_scs.default.id
+	password    = "Tf-test-123"
+
+	triggers = {
+		version = "%s"
+	}
+}
+`, name, version)
+}
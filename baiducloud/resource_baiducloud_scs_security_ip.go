@@ -0,0 +1,353 @@
+/*
+Use this resource to manage the IP whitelist of a SCS instance.
+
+~> **NOTE:** The resource takes over the whole whitelist of the instance, IPs not listed in `security_ips` will be removed.
+The internal IPs of the BCC instances bound to `security_group_id` are added to the whitelist too, and they are resolved
+again on every plan, so the whitelist follows the members of the security group.
+
+Example Usage
+
+```hcl
+resource "baiducloud_scs_security_ip" "default" {
+  instance_id  = "scs-bj-xxxxxxxx"
+  security_ips = ["192.168.1.0/24", "10.0.0.1"]
+}
+
+resource "baiducloud_scs_security_ip" "group" {
+  instance_id       = "scs-bj-xxxxxxxx"
+  security_group_id = "g-xxxxxxxx"
+}
+```
+
+Import
+
+SCS security ip can be imported by the instance id, e.g.
+
+```hcl
+$ terraform import baiducloud_scs_security_ip.default id
+```
+*/
+package baiducloud
+
+import (
+	"strings"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/services/scs"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func resourceBaiduCloudScsSecurityIp() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBaiduCloudScsSecurityIpCreate,
+		Read:   resourceBaiduCloudScsSecurityIpRead,
+		Update: resourceBaiduCloudScsSecurityIpUpdate,
+		Delete: resourceBaiduCloudScsSecurityIpDelete,
+
+		CustomizeDiff: resourceBaiduCloudScsSecurityIpCustomizeDiff,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the SCS instance.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"security_ips": {
+				Type:        schema.TypeSet,
+				Description: "IP whitelist of the instance, such as 192.168.1.1 or 192.168.1.0/24. A single IP is the same as the IP with /32 mask. At least one of security_ips and security_group_id must be set.",
+				Optional:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: scsSecurityIpHash,
+			},
+			"security_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the security group, the internal IPs of the BCC instances bound to it are added to the whitelist. They are resolved again on every plan.",
+				Optional:    true,
+			},
+			"security_group_ips": {
+				Type:        schema.TypeSet,
+				Description: "The internal IPs of the BCC instances bound to security_group_id, which are added to the whitelist.",
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Set: scsSecurityIpHash,
+			},
+		},
+	}
+}
+
+func resourceBaiduCloudScsSecurityIpCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("security_group_id") {
+		return d.SetNewComputed("security_group_ips")
+	}
+
+	securityGroupId := d.Get("security_group_id").(string)
+	groupIps := make([]string, 0)
+	if securityGroupId != "" {
+		bccService := BccService{meta.(*connectivity.BaiduClient)}
+		ips, err := bccService.ListSecurityGroupMemberIps(securityGroupId)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ip", "List members of security group "+securityGroupId, BCESDKGoERROR)
+		}
+		groupIps = ips
+	}
+
+	// only set the new value if the members changed, so that an unchanged group produces no diff
+	old := d.Get("security_group_ips").(*schema.Set)
+	if old.Len() != len(groupIps) {
+		return d.SetNew("security_group_ips", groupIps)
+	}
+	for _, ip := range groupIps {
+		if !old.Contains(ip) {
+			return d.SetNew("security_group_ips", groupIps)
+		}
+	}
+	return nil
+}
+
+func resourceBaiduCloudScsSecurityIpCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := d.Get("instance_id").(string)
+
+	scsInstanceMutex.Lock(instanceID)
+	defer scsInstanceMutex.Unlock(instanceID)
+
+	if err := reconcileScsSecurityIpsWithGroup(d, meta, instanceID); err != nil {
+		return err
+	}
+
+	d.SetId(instanceID)
+
+	return resourceBaiduCloudScsSecurityIpRead(d, meta)
+}
+
+func resourceBaiduCloudScsSecurityIpRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+
+	instanceID := d.Id()
+	action := "Query SCS security ip " + instanceID
+
+	result, err := scsService.GetSecurityIp(instanceID)
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ip", action, BCESDKGoERROR)
+	}
+
+	// keep the form written in the configuration if the api returns an equivalent one
+	configured := make(map[string]string)
+	for _, ip := range expandStringSet(d.Get("security_ips").(*schema.Set)) {
+		configured[normalizeScsSecurityIp(ip)] = ip
+	}
+
+	securityIps, groupIps := splitScsSecurityIps(result.SecurityIps, configured,
+		expandStringSet(d.Get("security_group_ips").(*schema.Set)))
+
+	d.Set("instance_id", instanceID)
+	d.Set("security_ips", securityIps)
+	d.Set("security_group_ips", groupIps)
+
+	return nil
+}
+
+func resourceBaiduCloudScsSecurityIpUpdate(d *schema.ResourceData, meta interface{}) error {
+	scsInstanceMutex.Lock(d.Id())
+	defer scsInstanceMutex.Unlock(d.Id())
+
+	if d.HasChange("security_ips") || d.HasChange("security_group_id") || d.HasChange("security_group_ips") {
+		if err := reconcileScsSecurityIpsWithGroup(d, meta, d.Id()); err != nil {
+			return err
+		}
+	}
+
+	return resourceBaiduCloudScsSecurityIpRead(d, meta)
+}
+
+func resourceBaiduCloudScsSecurityIpDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.BaiduClient)
+
+	instanceID := d.Id()
+	action := "Delete SCS security ip " + instanceID
+
+	scsInstanceMutex.Lock(instanceID)
+	defer scsInstanceMutex.Unlock(instanceID)
+
+	securityIps := expandStringSet(d.Get("security_ips").(*schema.Set))
+	securityIps = append(securityIps, expandStringSet(d.Get("security_group_ips").(*schema.Set))...)
+	if err := modifyScsSecurityIps(d, client, instanceID, nil, securityIps); err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ip", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+// reconcileScsSecurityIpsWithGroup makes the whitelist of the instance security_ips plus the members of
+// security_group_id, which are resolved again in case they changed since planning
+func reconcileScsSecurityIpsWithGroup(d *schema.ResourceData, meta interface{}, instanceID string) error {
+	action := "Update SCS security ip " + instanceID
+	expected := expandStringSet(d.Get("security_ips").(*schema.Set))
+
+	groupIps := make([]string, 0)
+	if securityGroupId := d.Get("security_group_id").(string); securityGroupId != "" {
+		bccService := BccService{meta.(*connectivity.BaiduClient)}
+		ips, err := bccService.ListSecurityGroupMemberIps(securityGroupId)
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ip", action, BCESDKGoERROR)
+		}
+		groupIps = ips
+	}
+	configured := make(map[string]bool)
+	for _, ip := range expected {
+		configured[normalizeScsSecurityIp(ip)] = true
+	}
+	for _, ip := range groupIps {
+		if !configured[normalizeScsSecurityIp(ip)] {
+			expected = append(expected, ip)
+		}
+	}
+	if len(expected) == 0 {
+		return WrapErrorf(Error("the whitelist can not be empty, set security_ips or a security_group_id with bound instances"),
+			DefaultErrorMsg, "baiducloud_scs_security_ip", action, BCESDKGoERROR)
+	}
+
+	if err := reconcileScsSecurityIps(d, meta, instanceID, expected); err != nil {
+		return err
+	}
+	d.Set("security_group_ips", groupIps)
+	return nil
+}
+
+// reconcileScsSecurityIps makes the whitelist of the instance the same as the expected one
+func reconcileScsSecurityIps(d *schema.ResourceData, meta interface{}, instanceID string, expected []string) error {
+	client := meta.(*connectivity.BaiduClient)
+	scsService := ScsService{client}
+	action := "Update SCS security ip " + instanceID
+
+	result, err := scsService.GetSecurityIp(instanceID)
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ip", action, BCESDKGoERROR)
+	}
+
+	current := make(map[string]bool)
+	for _, ip := range result.SecurityIps {
+		current[normalizeScsSecurityIp(ip)] = true
+	}
+	wanted := make(map[string]bool)
+	for _, ip := range expected {
+		wanted[normalizeScsSecurityIp(ip)] = true
+	}
+
+	add := make([]string, 0)
+	for _, ip := range expected {
+		if !current[normalizeScsSecurityIp(ip)] {
+			add = append(add, ip)
+		}
+	}
+	remove := make([]string, 0)
+	for _, ip := range result.SecurityIps {
+		if !wanted[normalizeScsSecurityIp(ip)] {
+			remove = append(remove, ip)
+		}
+	}
+
+	if err := modifyScsSecurityIps(d, client, instanceID, add, remove); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs_security_ip", action, BCESDKGoERROR)
+	}
+
+	return nil
+}
+
+func modifyScsSecurityIps(d *schema.ResourceData, client *connectivity.BaiduClient, instanceID string, add, remove []string) error {
+	scsService := ScsService{client}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	// add the new ips first, so that the whitelist is never empty during updating
+	if len(add) > 0 {
+		args := &scs.SecurityIpArgs{
+			SecurityIps: add,
+			ClientToken: buildClientToken(),
+		}
+		addDebug("Add SCS security ip "+instanceID, args)
+		if _, err := scsService.WithScsClientBackoff(timeout, func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.AddSecurityIp(instanceID, args)
+		}); err != nil {
+			return err
+		}
+	}
+
+	if len(remove) > 0 {
+		args := &scs.SecurityIpArgs{
+			SecurityIps: remove,
+			ClientToken: buildClientToken(),
+		}
+		addDebug("Delete SCS security ip "+instanceID, args)
+		if _, err := scsService.WithScsClientBackoff(timeout, func(scsClient *scs.Client) (interface{}, error) {
+			return nil, scsClient.DeleteSecurityIp(instanceID, args)
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// splitScsSecurityIps splits the whitelist of the instance into security_ips and security_group_ips.
+// An ip written in security_ips keeps the configured form, the other members of the security group
+// stay in security_group_ips, and any other ip is reported in security_ips as drift.
+func splitScsSecurityIps(whitelist []string, configured map[string]string, groupIps []string) (securityIps, members []string) {
+	group := make(map[string]bool)
+	for _, ip := range groupIps {
+		group[normalizeScsSecurityIp(ip)] = true
+	}
+
+	securityIps = make([]string, 0, len(whitelist))
+	members = make([]string, 0)
+	for _, ip := range whitelist {
+		key := normalizeScsSecurityIp(ip)
+		if group[key] {
+			members = append(members, ip)
+		}
+		if v, ok := configured[key]; ok {
+			securityIps = append(securityIps, v)
+		} else if !group[key] {
+			securityIps = append(securityIps, ip)
+		}
+	}
+	return securityIps, members
+}
+
+// normalizeScsSecurityIp treats a single ip and the ip with /32 mask as the same one
+func normalizeScsSecurityIp(ip string) string {
+	ip = strings.TrimSpace(ip)
+	return strings.TrimSuffix(ip, "/32")
+}
+
+func scsSecurityIpHash(v interface{}) int {
+	return hashcode.String(normalizeScsSecurityIp(v.(string)))
+}
@@ -0,0 +1,88 @@
+package baiducloud
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+const (
+	testAccScsSecurityIpResourceType = "baiducloud_scs_security_ip"
+	testAccScsSecurityIpResourceName = testAccScsSecurityIpResourceType + "." + BaiduCloudTestResourceName
+)
+
+func TestSplitScsSecurityIps(t *testing.T) {
+	whitelist := []string{"192.168.1.0/24", "10.0.0.1/32", "10.0.0.2", "10.0.0.3", "172.16.0.1"}
+	configured := map[string]string{"192.168.1.0/24": "192.168.1.0/24", "10.0.0.1": "10.0.0.1", "10.0.0.3": "10.0.0.3"}
+	groupIps := []string{"10.0.0.2", "10.0.0.3", "10.0.0.4"}
+
+	securityIps, members := splitScsSecurityIps(whitelist, configured, groupIps)
+	if expected := []string{"192.168.1.0/24", "10.0.0.1", "10.0.0.3", "172.16.0.1"}; !reflect.DeepEqual(securityIps, expected) {
+		t.Errorf("expected security_ips %v, got %v", expected, securityIps)
+	}
+	if expected := []string{"10.0.0.2", "10.0.0.3"}; !reflect.DeepEqual(members, expected) {
+		t.Errorf("expected security_group_ips %v, got %v", expected, members)
+	}
+}
+
+func TestAccBaiduCloudScsSecurityIp(t *testing.T) {
+	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
+	name := BaiduCloudTestResourceTypeNameScs + "-" + timeStamp
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccScsDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsSecurityIpConfig(name, `"192.168.1.0/24", "10.0.0.1"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsSecurityIpResourceName),
+					resource.TestCheckResourceAttr(testAccScsSecurityIpResourceName, "security_ips.#", "2"),
+				),
+			},
+			{
+				ResourceName:      testAccScsSecurityIpResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccScsSecurityIpConfig(name, `"10.0.0.1/32"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsSecurityIpResourceName),
+					resource.TestCheckResourceAttr(testAccScsSecurityIpResourceName, "security_ips.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccScsSecurityIpConfig(name, securityIps string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_scs" "default" {
+    instance_name           = "%s"
+	billing = {
+    	payment_timing 		= "Postpaid"
+  	}
+    purchase_count 			= 1
+  	port 					= 6379
+	engine_version 			= "3.2"
+	node_type 				= "cache.n1.micro"
+	cluster_type 			= "master_slave"
+	replication_num 		= 1
+	shard_num 				= 1
+	proxy_num 				= 0
+}
+
+resource "baiducloud_scs_security_ip" "default" {
+	instance_id  = baiducloud_scs.default.id
+	security_ips = [%s]
+}
+`, name, securityIps)
+}
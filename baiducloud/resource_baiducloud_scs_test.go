@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/terraform"
 	"log"
+	"reflect"
 	"strconv"
 	"strings"
 	"testing"
@@ -60,6 +61,54 @@ func testSweepScsInstances(region string) error {
 	return nil
 }
 
+func TestBuildScsBilling(t *testing.T) {
+	cases := []struct {
+		name     string
+		billing  map[string]interface{}
+		expected scs.Billing
+	}{
+		{
+			name:     "postpaid",
+			billing:  map[string]interface{}{"payment_timing": PaymentTimingPostpaid},
+			expected: scs.Billing{PaymentTiming: PaymentTimingPostpaid, Reservation: &scs.Reservation{}},
+		},
+		{
+			name: "postpaid ignores reservation",
+			billing: map[string]interface{}{
+				"payment_timing": PaymentTimingPostpaid,
+				"reservation":    map[string]interface{}{"reservation_length": 3, "reservation_time_unit": "Month"},
+			},
+			expected: scs.Billing{PaymentTiming: PaymentTimingPostpaid, Reservation: &scs.Reservation{}},
+		},
+		{
+			name: "prepaid",
+			billing: map[string]interface{}{
+				"payment_timing": PaymentTimingPrepai,
+				"reservation":    map[string]interface{}{"reservation_length": 3, "reservation_time_unit": "Month"},
+			},
+			expected: scs.Billing{
+				PaymentTiming: PaymentTimingPrepai,
+				Reservation:   &scs.Reservation{ReservationLength: 3, ReservationTimeUnit: "Month"},
+			},
+		},
+		{
+			name:    "prepaid default reservation",
+			billing: map[string]interface{}{"payment_timing": PaymentTimingPrepai},
+			expected: scs.Billing{
+				PaymentTiming: PaymentTimingPrepai,
+				Reservation:   &scs.Reservation{ReservationLength: 1, ReservationTimeUnit: "Month"},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		if got := buildScsBilling(c.billing); !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%s: expected %+v with reservation %+v, got %+v with reservation %+v",
+				c.name, c.expected, *c.expected.Reservation, got, *got.Reservation)
+		}
+	}
+}
+
 func TestAccBaiduCloudScs(t *testing.T) {
 	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
 	name := BaiduCloudTestResourceTypeNameScs + "-" + timeStamp
@@ -83,12 +132,15 @@ func TestAccBaiduCloudScs(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccScsResourceName, "replication_num", "1"),
 					resource.TestCheckResourceAttr(testAccScsResourceName, "shard_num", "1"),
 					resource.TestCheckResourceAttr(testAccScsResourceName, "node_type", "cache.n1.micro"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "security_group_ids.#", "0"),
+					resource.TestCheckResourceAttrSet(testAccScsResourceName, "domain_prefix"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "is_isolated", "false"),
 				),
 			},
 			{
 				ResourceName:            testAccScsResourceName,
 				ImportState:             true,
-				ImportStateVerifyIgnore: []string{"instance_status"},
+				ImportStateVerifyIgnore: []string{"instance_status", "password", "client_token"},
 			},
 			{
 				Config: testAccScsConfigUpdate(BaiduCloudTestResourceTypeNameScs),
@@ -99,10 +151,80 @@ func TestAccBaiduCloudScs(t *testing.T) {
 					resource.TestCheckResourceAttr(testAccScsResourceName, "cluster_type", "master_slave"),
 					resource.TestCheckResourceAttr(testAccScsResourceName, "engine_version", "3.2"),
 					resource.TestCheckResourceAttr(testAccScsResourceName, "replication_num", "1"),
-					resource.TestCheckResourceAttr(testAccScsResourceName, "shard_num", "2"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "shard_num", "1"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "parameters.#", "1"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "backup_config.0.backup_days", "Mon,Thu"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "tags.testKey", "testValue"),
+					resource.TestCheckNoResourceAttr(testAccScsResourceName, "tags.tf:description"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "description", "terraform test"),
 					resource.TestCheckResourceAttr(testAccScsResourceName, "node_type", "cache.n1.micro"),
 				),
 			},
+			{
+				Config: testAccScsConfigReplaceTags(BaiduCloudTestResourceTypeNameScs),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsResourceName),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "tags.newKey", "newValue"),
+					testAccCheckScsTagUnbound(testAccScsResourceName, "testKey"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckScsTagUnbound checks the tag is unbound from the instance by querying the api instead of the state
+func testAccCheckScsTagUnbound(n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("can't find resource: %s", n)
+		}
+
+		client := testAccProvider.Meta().(*connectivity.BaiduClient)
+		scsService := ScsService{client}
+		result, err := scsService.GetInstanceDetail(rs.Primary.ID)
+		if err != nil {
+			return WrapError(err)
+		}
+
+		for _, tag := range result.Tags {
+			if tag.TagKey == key {
+				return WrapError(Error("tag %s is still bound to SCS %s", key, rs.Primary.ID))
+			}
+		}
+		return nil
+	}
+}
+
+func TestAccBaiduCloudScsCapacity(t *testing.T) {
+	timeStamp := strconv.FormatInt(time.Now().Unix(), 10)
+	name := BaiduCloudTestResourceTypeNameScs + "-capacity-" + timeStamp
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccScsDestory,
+
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScsConfigCapacity(name, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsResourceName),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "capacity", "1"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "node_type", "cache.n1.micro"),
+				),
+			},
+			{
+				Config: testAccScsConfigCapacity(name, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBaiduCloudDataSourceId(testAccScsResourceName),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "capacity", "2"),
+					resource.TestCheckResourceAttr(testAccScsResourceName, "node_type", "cache.n1.small"),
+				),
+			},
 		},
 	})
 }
@@ -157,6 +279,7 @@ resource "baiducloud_scs" "default" {
   	}
     purchase_count 			= 1
   	port 					= 6379
+	password 				= "Tf-test-123"
 	engine_version 			= "3.2"
 	node_type 				= "cache.n1.micro"
 	cluster_type 			= "master_slave"
@@ -176,12 +299,76 @@ resource "baiducloud_scs" "default" {
   	}
     purchase_count 			= 1
   	port 					= 6379
+	password 				= "Tf-test-456"
+	engine_version 			= "3.2"
+	node_type 				= "cache.n1.micro"
+	cluster_type 			= "master_slave"
+	replication_num 		= 1
+	shard_num 				= 1
+	proxy_num 				= 0
+	parameters {
+		name  = "timeout"
+		value = "300"
+	}
+	backup_config {
+		backup_days = "Mon,Thu"
+		backup_time = "01:05:00"
+	}
+	description 			= "terraform test"
+	tags = {
+		"testKey" = "testValue"
+	}
+}
+`, name+"-update")
+}
+
+func testAccScsConfigReplaceTags(name string) string {
+	return fmt.Sprintf(`
+resource "baiducloud_scs" "default" {
+    instance_name           = "%s"
+	billing = {
+    	payment_timing 		= "Postpaid"
+  	}
+    purchase_count 			= 1
+  	port 					= 6379
+	password 				= "Tf-test-456"
 	engine_version 			= "3.2"
 	node_type 				= "cache.n1.micro"
 	cluster_type 			= "master_slave"
 	replication_num 		= 1
-	shard_num 				= 2
+	shard_num 				= 1
 	proxy_num 				= 0
+	parameters {
+		name  = "timeout"
+		value = "300"
+	}
+	backup_config {
+		backup_days = "Mon,Thu"
+		backup_time = "01:05:00"
+	}
+	description 			= "terraform test"
+	tags = {
+		"newKey" = "newValue"
+	}
 }
 `, name+"-update")
 }
+
+func testAccScsConfigCapacity(name string, capacity int) string {
+	return fmt.Sprintf(`
+resource "baiducloud_scs" "default" {
+    instance_name           = "%s"
+	billing = {
+    	payment_timing 		= "Postpaid"
+  	}
+    purchase_count 			= 1
+  	port 					= 6379
+	engine_version 			= "3.2"
+	capacity 				= %d
+	cluster_type 			= "master_slave"
+	replication_num 		= 1
+	shard_num 				= 1
+	proxy_num 				= 0
+}
+`, name, capacity)
+}
@@ -136,7 +136,7 @@ func resourceBaiduCloudSecurityGroupRead(d *schema.ResourceData, meta interface{
 				d.Set("name", sg.Name)
 				d.Set("description", sg.Desc)
 				d.Set("vpc_id", sg.VpcId)
-				d.Set("tags", flattenTagsToMap(sg.Tags))
+				d.Set("tags", flattenTagsToMap(client, sg.Tags))
 
 				return nil
 			}
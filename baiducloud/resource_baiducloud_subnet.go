@@ -150,7 +150,7 @@ func resourceBaiduCloudSubnetRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("vpc_id", result.Subnet.VPCId)
 	d.Set("subnet_type", result.Subnet.SubnetType)
 	d.Set("description", result.Subnet.Description)
-	d.Set("tags", flattenTagsToMap(result.Subnet.Tags))
+	d.Set("tags", flattenTagsToMap(client, result.Subnet.Tags))
 
 	return nil
 }
@@ -135,7 +135,7 @@ func resourceBaiduCloudVpcRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", result.VPC.Name)
 	d.Set("description", result.VPC.Description)
 	d.Set("cidr", result.VPC.Cidr)
-	d.Set("tags", flattenTagsToMap(result.VPC.Tags))
+	d.Set("tags", flattenTagsToMap(client, result.VPC.Tags))
 	d.Set("secondary_cidrs", result.VPC.SecondaryCidr)
 
 	//computed attribute
@@ -137,7 +137,7 @@ func (s *APPBLBService) FlattenAppBLBDetailsToMap(models []appblb.AppBLBModel, d
 			"create_time":  detail.CreateTime,
 			"release_time": detail.ReleaseTime,
 			"listener":     s.FlattenListenerModelToMap(detail.Listener),
-			"tags":         flattenTagsToMap(model.Tags),
+			"tags":         flattenTagsToMap(s.client, model.Tags),
 		})
 	}
 
@@ -281,7 +281,7 @@ func (s *BccService) FlattenCDSVolumeModelToMap(cdsList []api.VolumeModel) []map
 			"description":      c.Desc,
 			"attachments":      s.FlattenVolumeAttachmentModelToMap(c.Attachments),
 			"zone_name":        c.ZoneName,
-			"tags":             flattenTagsToMap(c.Tags),
+			"tags":             flattenTagsToMap(s.client, c.Tags),
 			"is_system_volume": c.IsSystemVolume,
 			"region_id":        c.RegionId,
 			"snapshot_num":     c.SnapshotNum,
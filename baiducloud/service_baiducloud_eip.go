@@ -192,6 +192,7 @@ func (e *EipService) ListAllEips(listArgs *eip.ListEipArgs) ([]eip.EipModel, err
 }
 
 func (e *EipService) FlattenEipModelsToMap(eips []eip.EipModel) []map[string]interface{} {
+	client := e.client
 	result := make([]map[string]interface{}, 0, len(eips))
 
 	for _, e := range eips {
@@ -206,7 +207,7 @@ func (e *EipService) FlattenEipModelsToMap(eips []eip.EipModel) []map[string]int
 			"billing_method":    e.BillingMethod,
 			"create_time":       e.CreateTime,
 			"expire_time":       e.ExpireTime,
-			"tags":              flattenTagsToMap(e.Tags),
+			"tags":              flattenTagsToMap(client, e.Tags),
 		})
 	}
 
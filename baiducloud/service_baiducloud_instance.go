@@ -1,6 +1,7 @@
 package baiducloud
 
 import (
+	"sort"
 	"time"
 
 	"github.com/baidubce/bce-sdk-go/services/bcc"
@@ -61,6 +62,23 @@ func (s *BccService) ListAllInstance(args *api.ListInstanceArgs) ([]api.Instance
 	}
 }
 
+// ListSecurityGroupMemberIps returns the sorted internal ips of the instances bound to the security group
+func (s *BccService) ListSecurityGroupMemberIps(securityGroupId string) ([]string, error) {
+	instances, err := s.ListAllInstance(&api.ListInstanceArgs{})
+	if err != nil {
+		return nil, err
+	}
+
+	ips := make([]string, 0)
+	for _, inst := range instances {
+		if inst.InternalIP != "" && stringInSlice(inst.NicInfo.SecurityGroups, securityGroupId) {
+			ips = append(ips, inst.InternalIP)
+		}
+	}
+	sort.Strings(ips)
+	return ips, nil
+}
+
 func (s *BccService) GetInstanceDetail(instanceID string) (*api.InstanceModel, error) {
 	action := "Get instance detail " + instanceID
 
@@ -191,7 +209,7 @@ func (s *BccService) FlattenInstanceModelToMap(instances []api.InstanceModel) ([
 			"auto_renew":               inst.AutoRenew,
 			"keypair_id":               inst.KeypairId,
 			"keypair_name":             inst.KeypairName,
-			"tags":                     flattenTagsToMap(inst.Tags),
+			"tags":                     flattenTagsToMap(s.client, inst.Tags),
 		})
 	}
 
@@ -1,45 +1,162 @@
 package baiducloud
 
 import (
+	"log"
+	"time"
+
+	"github.com/baidubce/bce-sdk-go/bce"
 	"github.com/baidubce/bce-sdk-go/services/scs"
 	"github.com/hashicorp/terraform/helper/resource"
 
 	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
 
+// ScsRefreshRequestTimeout caps a single query of the instance status while waiting for a target status,
+// so that a hung request fails the wait instead of blocking it beyond the timeout of the resource
+const ScsRefreshRequestTimeout = 2 * time.Minute
+
 type ScsService struct {
 	client *connectivity.BaiduClient
 }
 
+// scsListPageFunc requests a page of a list api starting from marker, collects the items of the page and
+// returns the marker and size of the next page
+type scsListPageFunc func(scsClient *scs.Client, marker string, maxKeys int) (nextMarker string, nextMaxKeys int, isTruncated bool, err error)
+
+// ListAllPages requests every page of a marker paginated list api by listPage, starting from marker and maxKeys
+// which may be empty to use the defaults of the api
+func (s *ScsService) ListAllPages(marker string, maxKeys int, listPage scsListPageFunc) error {
+	return listAllScsPages(marker, maxKeys, func(marker string, maxKeys int) (string, int, bool, error) {
+		var nextMarker string
+		var nextMaxKeys int
+		var isTruncated bool
+		_, err := s.client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+			var err error
+			nextMarker, nextMaxKeys, isTruncated, err = listPage(scsClient, marker, maxKeys)
+			return nil, err
+		})
+		return nextMarker, nextMaxKeys, isTruncated, err
+	})
+}
+
+func listAllScsPages(marker string, maxKeys int, listPage func(marker string, maxKeys int) (string, int, bool, error)) error {
+	for {
+		nextMarker, nextMaxKeys, isTruncated, err := listPage(marker, maxKeys)
+		if err != nil {
+			return err
+		}
+		if !isTruncated {
+			return nil
+		}
+		// stop instead of requesting the same page forever or returning a truncated list silently
+		if nextMarker == "" || nextMarker == marker {
+			return WrapError(Error("the list is truncated at marker %q but the next marker is %q", marker, nextMarker))
+		}
+		marker, maxKeys = nextMarker, nextMaxKeys
+	}
+}
+
 func (s *ScsService) ListAllInstances(args *scs.ListInstancesArgs) ([]scs.InstanceModel, error) {
 	result := make([]scs.InstanceModel, 0)
 
 	action := "List all SCS instance "
-	for {
-		raw, err := s.client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
-			return scsClient.ListInstances(args)
-		})
+	err := s.ListAllPages(args.Marker, args.MaxKeys, func(scsClient *scs.Client, marker string, maxKeys int) (string, int, bool, error) {
+		args.Marker, args.MaxKeys = marker, maxKeys
+		response, err := scsClient.ListInstances(args)
 		if err != nil {
-			return nil, err
+			return "", 0, false, err
 		}
-		addDebug(action, raw)
+		addDebug(action, response)
 
-		response := raw.(*scs.ListInstancesResult)
 		result = append(result, response.Instances...)
+		return response.NextMarker, response.MaxKeys, response.IsTruncated, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (s *ScsService) ListAllRecycleInstances() ([]scs.RecycleInstance, error) {
+	result := make([]scs.RecycleInstance, 0)
+
+	action := "List all SCS recycle instance "
+	err := s.ListAllPages("", 0, func(scsClient *scs.Client, marker string, maxKeys int) (string, int, bool, error) {
+		response, err := scsClient.ListRecycleInstances(&scs.Marker{Marker: marker, MaxKeys: maxKeys})
+		if err != nil {
+			return "", 0, false, err
+		}
+		addDebug(action, response)
 
-		if response.IsTruncated {
-			args.Marker = response.NextMarker
-			args.MaxKeys = response.MaxKeys
-		} else {
-			return result, nil
+		result = append(result, response.Result...)
+		return response.NextMarker, response.MaxKeys, response.IsTruncated, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// WithScsClientBackoff calls do with the scs client until it succeeds or fails with an error which is not
+// retryable according to IsRetryableScsError, waiting scsRetryBackoff between attempts.
+// It gives up with the last error once timeout elapses.
+func (s *ScsService) WithScsClientBackoff(timeout time.Duration, do func(*scs.Client) (interface{}, error),
+	extraCodes ...string) (interface{}, error) {
+	deadline := time.Now().Add(timeout)
+	for attempt := 0; ; attempt++ {
+		raw, err := s.client.WithScsClient(do)
+		if err == nil || !IsRetryableScsError(err, extraCodes...) {
+			return raw, err
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return raw, err
+		}
+		wait := scsRetryBackoff(err, attempt)
+		if wait > remaining {
+			wait = remaining
+		}
+		log.Printf("[DEBUG] retry scs request in %s after error: %v", wait, err)
+		time.Sleep(wait)
+	}
+}
+
+// GetInstanceIdByName returns the id of the instance named name which is not being deleted, it is empty if there is
+// no such instance
+func (s *ScsService) GetInstanceIdByName(name string) (string, error) {
+	instances, err := s.ListAllInstances(&scs.ListInstancesArgs{})
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0)
+	for _, instance := range instances {
+		if instance.InstanceName != name || stringInSlice([]string{SCSStatusStatusDeleting, SCSStatusStatusDeleted,
+			SCSSTatusStatusIsolated}, instance.InstanceStatus) {
+			continue
 		}
+		ids = append(ids, instance.InstanceID)
+	}
+	switch len(ids) {
+	case 0:
+		return "", nil
+	case 1:
+		return ids[0], nil
+	default:
+		return "", WrapError(Error("%d SCS instances are named %s: %v, can not decide which one to adopt", len(ids), name, ids))
 	}
 }
 
+// InstanceStateRefresh refreshes the status of the instance. An instance which is not found yet, e.g. right after
+// it is created, is polled again until the not found checks of the wait are exhausted.
 func (s *ScsService) InstanceStateRefresh(instanceId string, failState []string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		result, err := s.GetInstanceDetail(instanceId)
+		result, err := s.GetInstanceDetailWithTimeout(instanceId, ScsRefreshRequestTimeout)
 		if err != nil {
+			if NotFoundError(err) {
+				return nil, "", nil
+			}
 			return nil, "", WrapError(err)
 		}
 
@@ -53,6 +170,22 @@ func (s *ScsService) InstanceStateRefresh(instanceId string, failState []string)
 	}
 }
 
+// InstanceDeleteStateRefresh refreshes the status of the instance being deleted, the instance may be removed
+// entirely after deleting, so an instance which is not found is deleted
+func (s *ScsService) InstanceDeleteStateRefresh(instanceId string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		result, err := s.GetInstanceDetailWithTimeout(instanceId, ScsRefreshRequestTimeout)
+		if err != nil {
+			if NotFoundError(err) {
+				return 0, SCSStatusStatusDeleted, nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		return result, result.InstanceStatus, nil
+	}
+}
+
 func (s *ScsService) GetInstanceDetail(instanceID string) (*scs.GetInstanceDetailResult, error) {
 	action := "Get SCS instance detail " + instanceID
 	raw, err := s.client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
@@ -67,6 +200,39 @@ func (s *ScsService) GetInstanceDetail(instanceID string) (*scs.GetInstanceDetai
 	return result, nil
 }
 
+// GetInstanceDetailWithTimeout bounds the request of the detail of the instance by the timeout, or by the connection
+// timeout of the provider if it is shorter. The timeout is set on the scs client for this request only, and the request
+// is not retried by the sdk, so that a hung request fails once the timeout is exceeded instead of blocking the wait
+// several times as long while holding the lock of the sdk clients. The caller, usually a wait for a status, retries.
+func (s *ScsService) GetInstanceDetailWithTimeout(instanceID string, timeout time.Duration) (*scs.GetInstanceDetailResult, error) {
+	action := "Get SCS instance detail " + instanceID
+	raw, err := s.client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+		defaultTimeout, defaultRetry := scsClient.Config.ConnectionTimeoutInMillis, scsClient.Config.Retry
+		if capped := int(timeout / time.Millisecond); defaultTimeout <= 0 || capped < defaultTimeout {
+			scsClient.Config.ConnectionTimeoutInMillis = capped
+		}
+		timeout = time.Duration(scsClient.Config.ConnectionTimeoutInMillis) * time.Millisecond
+		scsClient.Config.Retry = bce.NewNoRetryPolicy()
+		defer func() {
+			scsClient.Config.ConnectionTimeoutInMillis = defaultTimeout
+			scsClient.Config.Retry = defaultRetry
+		}()
+
+		return scsClient.GetInstanceDetail(instanceID)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		if IsRequestTimeoutError(err) {
+			return nil, WrapErrorf(Error("the request of the detail of SCS instance %s timed out after %s: %s", instanceID, timeout, err),
+				DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	result, _ := raw.(*scs.GetInstanceDetailResult)
+	return result, nil
+}
+
 func (s *ScsService) GetNodeTypeList() (*scs.GetNodeTypeListResult, error) {
 	action := "Get SCS nodetype list "
 	raw, err := s.client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
@@ -81,7 +247,64 @@ func (s *ScsService) GetNodeTypeList() (*scs.GetNodeTypeListResult, error) {
 	return result, nil
 }
 
+func (s *ScsService) GetParameters(instanceID string) (*scs.GetParametersResult, error) {
+	action := "Get SCS instance parameters " + instanceID
+	raw, err := s.client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+		return scsClient.GetParameters(instanceID)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	result, _ := raw.(*scs.GetParametersResult)
+	return result, nil
+}
+
+func (s *ScsService) GetSecurityIp(instanceID string) (*scs.GetSecurityIpResult, error) {
+	action := "Get SCS instance security ip " + instanceID
+	raw, err := s.client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+		return scsClient.GetSecurityIp(instanceID)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	result, _ := raw.(*scs.GetSecurityIpResult)
+	return result, nil
+}
+
+func (s *ScsService) ListSecurityGroupByInstanceId(instanceID string) (*scs.ListSecurityGroupResult, error) {
+	action := "List SCS instance security groups " + instanceID
+	raw, err := s.client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+		return scsClient.ListSecurityGroupByInstanceId(instanceID)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	result, _ := raw.(*scs.ListSecurityGroupResult)
+	return result, nil
+}
+
+func (s *ScsService) GetBackupList(instanceID string) (*scs.GetBackupListResult, error) {
+	action := "Get SCS instance backup list " + instanceID
+	raw, err := s.client.WithScsClient(func(scsClient *scs.Client) (interface{}, error) {
+		return scsClient.GetBackupList(instanceID)
+	})
+	addDebug(action, raw)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, "baiducloud_scs", action, BCESDKGoERROR)
+	}
+
+	result, _ := raw.(*scs.GetBackupListResult)
+	return result, nil
+}
+
 func (e *ScsService) FlattenScsModelsToMap(scss []scs.InstanceModel) []map[string]interface{} {
+	client := e.client
 	result := make([]map[string]interface{}, 0, len(scss))
 
 	for _, e := range scss {
@@ -100,7 +323,7 @@ func (e *ScsService) FlattenScsModelsToMap(scss []scs.InstanceModel) []map[strin
 			"used_capacity":   e.UsedCapacity,
 			"payment_timing":  e.PaymentTiming,
 			"zone_names":      e.ZoneNames,
-			"tags":            flattenTagsToMap(e.Tags),
+			"tags":            flattenTagsToMap(client, e.Tags),
 		})
 	}
 	return result
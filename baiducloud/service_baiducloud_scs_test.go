@@ -0,0 +1,119 @@
+package baiducloud
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func TestListAllScsPages(t *testing.T) {
+	pages := map[string]struct {
+		items      []string
+		nextMarker string
+		truncated  bool
+	}{
+		"":   {[]string{"a", "b"}, "m1", true},
+		"m1": {[]string{"c", "d"}, "m2", true},
+		"m2": {[]string{"e"}, "", false},
+	}
+
+	items := make([]string, 0)
+	requests := make([]int, 0)
+	err := listAllScsPages("", 0, func(marker string, maxKeys int) (string, int, bool, error) {
+		requests = append(requests, maxKeys)
+		page := pages[marker]
+		items = append(items, page.items...)
+		return page.nextMarker, 2, page.truncated, nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(items, expected) {
+		t.Fatalf("expected items %v, got %v", expected, items)
+	}
+	if expected := []int{0, 2, 2}; !reflect.DeepEqual(requests, expected) {
+		t.Fatalf("expected max keys of requests %v, got %v", expected, requests)
+	}
+}
+
+func TestListAllScsPagesTruncatedWithoutMarker(t *testing.T) {
+	count := 0
+	err := listAllScsPages("", 0, func(marker string, maxKeys int) (string, int, bool, error) {
+		count++
+		if count > 2 {
+			t.Fatalf("the same page is requested again")
+		}
+		return "", 0, true, nil
+	})
+	if err == nil {
+		t.Fatalf("expected an error for a truncated list without the next marker")
+	}
+}
+
+func TestGetInstanceDetailWithTimeout(t *testing.T) {
+	var requests int32
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		select {
+		case <-done:
+		case <-time.After(10 * time.Second):
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	// the connection timeout of the provider is shorter than the cap, so it bounds the request
+	scsService := newScsTestService(t, server.URL, 1000)
+
+	_, err := scsService.GetInstanceDetailWithTimeout("scs-bj-test", ScsRefreshRequestTimeout)
+	if err == nil || !strings.Contains(err.Error(), "timed out after 1s") {
+		t.Fatalf("expected a timeout error after 1s, got %v", err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("expected the request not to be retried, got %d requests", n)
+	}
+}
+
+func TestScsInstanceStateRefreshNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":"InstanceNotExist","message":"instance not exist","requestId":"req"}`))
+	}))
+	defer server.Close()
+	scsService := newScsTestService(t, server.URL, 0)
+
+	// a transient not found of a new or modified instance is polled again instead of ending the wait
+	result, state, err := scsService.InstanceStateRefresh("scs-bj-test", []string{SCSStatusStatusFailed})()
+	if result != nil || state != "" || err != nil {
+		t.Fatalf("expected the instance to be polled again, got %v %q %v", result, state, err)
+	}
+
+	_, state, err = scsService.InstanceDeleteStateRefresh("scs-bj-test")()
+	if state != SCSStatusStatusDeleted || err != nil {
+		t.Fatalf("expected the deleted instance to be %s, got %q %v", SCSStatusStatusDeleted, state, err)
+	}
+}
+
+// newScsTestService builds a scs service whose requests are sent to endpoint
+func newScsTestService(t *testing.T, endpoint string, connectionTimeout int) ScsService {
+	config := &connectivity.Config{
+		AccessKey:         "ak",
+		SecretKey:         "sk",
+		Region:            connectivity.RegionBeiJing,
+		ConnectionTimeout: connectionTimeout,
+		ConfigEndpoints:   connectivity.ConfigEndpoints{connectivity.SCSCode: endpoint},
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("failed to build the client: %v", err)
+	}
+	return ScsService{client}
+}
@@ -14,7 +14,7 @@ func (s *BccService) FlattenSecurityGroupModelToMap(sgList []api.SecurityGroupMo
 			"name":        sg.Name,
 			"vpc_id":      sg.VpcId,
 			"description": sg.Desc,
-			"tags":        flattenTagsToMap(sg.Tags),
+			"tags":        flattenTagsToMap(s.client, sg.Tags),
 		})
 	}
 
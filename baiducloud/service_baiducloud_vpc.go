@@ -37,6 +37,29 @@ func (s *VpcService) ListAllVpcs() ([]vpc.VPC, error) {
 	return vpcs, nil
 }
 
+// GetVpcIdByName returns the id of the only VPC with the name
+func (s *VpcService) GetVpcIdByName(name string) (string, error) {
+	vpcs, err := s.ListAllVpcs()
+	if err != nil {
+		return "", err
+	}
+
+	ids := make([]string, 0)
+	for _, v := range vpcs {
+		if v.Name == name {
+			ids = append(ids, v.VPCID)
+		}
+	}
+	switch len(ids) {
+	case 0:
+		return "", WrapError(Error("no VPC is named %s", name))
+	case 1:
+		return ids[0], nil
+	default:
+		return "", WrapError(Error("%d VPCs are named %s: %v, please specify vpc_id instead", len(ids), name, ids))
+	}
+}
+
 func (s *VpcService) NatGatewayStateRefresh(natId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		action := "Query Nat Gateway " + natId
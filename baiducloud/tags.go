@@ -3,6 +3,8 @@ package baiducloud
 import (
 	"github.com/baidubce/bce-sdk-go/model"
 	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
 
 func tagsSchema() *schema.Schema {
@@ -28,9 +30,29 @@ func tagsComputedSchema() *schema.Schema {
 	}
 }
 
-func flattenTagsToMap(tags []model.TagModel) map[string]string {
+// expandIgnoreTags returns the keys and the key prefixes of the ignore_tags block of the provider
+func expandIgnoreTags(list []interface{}) (keys, keyPrefixes []string) {
+	if len(list) == 0 || list[0] == nil {
+		return nil, nil
+	}
+
+	ignoreTags := list[0].(map[string]interface{})
+	for _, key := range ignoreTags["keys"].(*schema.Set).List() {
+		keys = append(keys, key.(string))
+	}
+	for _, prefix := range ignoreTags["key_prefixes"].(*schema.Set).List() {
+		keyPrefixes = append(keyPrefixes, prefix.(string))
+	}
+	return keys, keyPrefixes
+}
+
+// flattenTagsToMap converts the tags to a map, leaving out the tags ignored by the provider
+func flattenTagsToMap(client *connectivity.BaiduClient, tags []model.TagModel) map[string]string {
 	tagMap := make(map[string]string)
 	for _, tag := range tags {
+		if client.IgnoreTag(tag.TagKey) {
+			continue
+		}
 		tagMap[tag.TagKey] = tag.TagValue
 	}
 
@@ -0,0 +1,47 @@
+package baiducloud
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/baidubce/bce-sdk-go/model"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+// newIgnoreTagsClient builds a client with the ignore_tags of the provider, no request is sent by it
+func newIgnoreTagsClient(t *testing.T, keys, keyPrefixes []string) *connectivity.BaiduClient {
+	config := &connectivity.Config{
+		AccessKey:            "ak",
+		SecretKey:            "sk",
+		IgnoreTagKeys:        keys,
+		IgnoreTagKeyPrefixes: keyPrefixes,
+	}
+	client, err := config.Client()
+	if err != nil {
+		t.Fatalf("failed to build the client: %v", err)
+	}
+	return client
+}
+
+func TestFlattenTagsToMapIgnoreTags(t *testing.T) {
+	tags := []model.TagModel{
+		{TagKey: "env", TagValue: "test"},
+		{TagKey: "CostCenter", TagValue: "rd"},
+		{TagKey: "finance:owner", TagValue: "ops"},
+	}
+
+	// the clients of aliased providers keep their own ignore_tags
+	plainClient := newIgnoreTagsClient(t, nil, nil)
+	ignoreClient := newIgnoreTagsClient(t, []string{"CostCenter"}, []string{"finance:"})
+
+	expected := map[string]string{"env": "test", "CostCenter": "rd", "finance:owner": "ops"}
+	if got := flattenTagsToMap(plainClient, tags); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v without ignore_tags, got %v", expected, got)
+	}
+
+	expected = map[string]string{"env": "test"}
+	if got := flattenTagsToMap(ignoreClient, tags); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("expected %v with ignore_tags, got %v", expected, got)
+	}
+}
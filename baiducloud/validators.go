@@ -2,12 +2,19 @@ package baiducloud
 
 import (
 	"fmt"
+	"net/url"
 	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/baidubce/bce-sdk-go/services/bos/api"
+	"github.com/baidubce/bce-sdk-go/services/scs"
 	"github.com/baidubce/bce-sdk-go/services/vpc"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
 )
 
 func validateReservationLength() schema.SchemaValidateFunc {
@@ -26,6 +33,11 @@ func validatePort() schema.SchemaValidateFunc {
 	return validation.IntBetween(1, 65535)
 }
 
+// validateScsPort checks the port is in the range allowed by scs, well-known ports below 1025 are not allowed
+func validateScsPort() schema.SchemaValidateFunc {
+	return validation.IntBetween(1025, 65534)
+}
+
 func validateHttpMethod() schema.SchemaValidateFunc {
 	return validation.StringInSlice([]string{
 		"GET",
@@ -145,3 +157,200 @@ func validateCFCMemorySize(v interface{}, k string) (ws []string, errors []error
 
 	return
 }
+
+func validateScsParameterName() schema.SchemaValidateFunc {
+	// these parameters are maintained by scs itself and can not be modified
+	readOnlyParameters := []string{
+		"bind", "port", "dir", "dbfilename", "requirepass", "masterauth",
+		"maxmemory", "cluster-enabled", "cluster-config-file", "slaveof", "replicaof",
+	}
+
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if stringInSlice(readOnlyParameters, value) {
+			errors = append(errors, fmt.Errorf("%q is a read-only parameter of scs and can not be modified, got %s", k, value))
+		}
+		return
+	}
+}
+
+// validateScsInstanceName checks the length of the name in characters rather than bytes,
+// so that names in Chinese are measured the same way as the scs console does
+func validateScsInstanceName() schema.SchemaValidateFunc {
+	isLetter := func(r rune) bool {
+		return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || unicode.Is(unicode.Han, r)
+	}
+
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(string)
+		if count := utf8.RuneCountInString(value); count < 1 || count > 65 {
+			errors = append(errors, fmt.Errorf("length of %q must be 1-65 characters, got %d", k, count))
+			return
+		}
+
+		first, _ := utf8.DecodeRuneInString(value)
+		if !isLetter(first) {
+			errors = append(errors, fmt.Errorf("%q must start with a letter, got %s", k, value))
+		}
+		for _, r := range value {
+			if !isLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("-_/.", r) {
+				errors = append(errors, fmt.Errorf("%q can only contain letters, numbers, Chinese and -_/., got %q in %s", k, r, value))
+				break
+			}
+		}
+		return
+	}
+}
+
+func validateScsBackupDays() schema.SchemaValidateFunc {
+	weekdays := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		for _, day := range strings.Split(v.(string), ",") {
+			if !stringInSlice(weekdays, strings.TrimSpace(day)) {
+				errors = append(errors, fmt.Errorf("%q must be weekdays in %v separated by commas, got %s", k, weekdays, day))
+			}
+		}
+		return
+	}
+}
+
+func validateScsPurchaseCount() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := v.(int)
+		if value != 1 {
+			errors = append(errors, fmt.Errorf("%q must be 1, one baiducloud_scs resource manages exactly one instance, use count or for_each to create more instances, got %d", k, value))
+		}
+		return
+	}
+}
+
+func validateScsTags() schema.SchemaValidateFunc {
+	// tag keys with these prefixes are reserved by the cloud and can not be bound by users
+	reservedPrefixes := []string{"bce:", "baidu:"}
+
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		for key, value := range v.(map[string]interface{}) {
+			if key == "" || utf8.RuneCountInString(key) > 65 {
+				errors = append(errors, fmt.Errorf("length of the key of %q must be 1-65, got %s", k, key))
+			}
+			if utf8.RuneCountInString(value.(string)) > 65 {
+				errors = append(errors, fmt.Errorf("length of the value of %q must be 0-65, got %s", k, value))
+			}
+			for _, prefix := range reservedPrefixes {
+				if strings.HasPrefix(strings.ToLower(key), prefix) {
+					errors = append(errors, fmt.Errorf("key of %q can not start with %s which is reserved by the system, got %s", k, prefix, key))
+				}
+			}
+			if key == ScsDescriptionTagKey {
+				errors = append(errors, fmt.Errorf("key of %q can not be %s which is reserved for description, set description instead", k, key))
+			}
+		}
+		return
+	}
+}
+
+// checkScsSubnets checks the zones of the subnets are unique and no more than replication_num,
+// since every zone must hold at least one replica. It does not depend on the cluster type.
+func checkScsSubnets(replicationNum int, subnets []scs.Subnet) error {
+	zones := make(map[string]bool)
+	for _, subnet := range subnets {
+		if zones[subnet.ZoneName] {
+			return fmt.Errorf("zone_name of subnets must be unique, %s is duplicated", subnet.ZoneName)
+		}
+		zones[subnet.ZoneName] = true
+	}
+
+	if len(zones) > replicationNum {
+		return fmt.Errorf("subnets can be in at most replication_num (%d) different zones, but %d zones are given",
+			replicationNum, len(zones))
+	}
+
+	return nil
+}
+
+// checkScsShardNum checks the shard number is allowed by the architecture of the scs instance
+func checkScsShardNum(clusterType string, shardNum int) error {
+	switch clusterType {
+	case "master_slave":
+		if shardNum != 1 {
+			return fmt.Errorf("shard_num of the master_slave instance must be 1, got %d", shardNum)
+		}
+	case "cluster":
+		allowed := []int{2, 4, 6, 8, 12, 16, 24, 32, 48, 64, 96, 128}
+		for _, num := range allowed {
+			if shardNum == num {
+				return nil
+			}
+		}
+		return fmt.Errorf("shard_num of the cluster instance must be one of %v, got %d", allowed, shardNum)
+	}
+	return nil
+}
+
+// checkScsNodeType checks the node type is in the spec list of the architecture of the scs instance
+func checkScsNodeType(clusterType, nodeType string, specs *scs.GetNodeTypeListResult) error {
+	nodeTypes := specs.DefaultNodeTypeList
+	if clusterType == "cluster" {
+		nodeTypes = specs.ClusterNodeTypeList
+	}
+
+	valid := make([]string, 0, len(nodeTypes))
+	for _, spec := range nodeTypes {
+		if spec.NodeType == nodeType {
+			return nil
+		}
+		valid = append(valid, spec.NodeType)
+	}
+	return fmt.Errorf("node_type %s is not available for the %s instance, valid values are: %s",
+		nodeType, clusterType, strings.Join(valid, ", "))
+}
+
+// validateEndpoint checks the endpoint is a host with an optional scheme and port, such as
+// redis.bj.baidubce.com or https://redis.bj.baidubce.com:443, empty means the default endpoint of the region
+func validateEndpoint() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value := strings.TrimSpace(v.(string))
+		if value == "" {
+			return
+		}
+
+		rawURL := value
+		if !strings.Contains(rawURL, "://") {
+			rawURL = "http://" + rawURL
+		}
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			errors = append(errors, fmt.Errorf("%q must be a well-formed endpoint, got %s: %s", k, value, err))
+			return
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			errors = append(errors, fmt.Errorf("%q must use http or https, got %s", k, value))
+		}
+		if u.Hostname() == "" || strings.ContainsAny(u.Host, " \t") {
+			errors = append(errors, fmt.Errorf("%q must contain a valid host, got %s", k, value))
+		}
+		if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" {
+			errors = append(errors, fmt.Errorf("%q must not contain a path or query, got %s", k, value))
+		}
+		return
+	}
+}
+
+// checkRegion normalizes the case of the region and checks it is a known region. An unknown region is accepted
+// if the endpoint of any service is customized by the endpoints block, environment variables or endpoints.xml,
+// the services without a custom endpoint are checked again when their clients are created.
+func checkRegion(region string, configEndpoints connectivity.ConfigEndpoints) (connectivity.Region, error) {
+	validRegion := connectivity.Region(strings.ToLower(strings.TrimSpace(region)))
+	if validRegion == "" || connectivity.IsKnownRegion(validRegion) {
+		return validRegion, nil
+	}
+
+	for _, serviceCode := range connectivity.ServiceCodes {
+		if connectivity.HasCustomEndpoint(validRegion, serviceCode, configEndpoints) {
+			return validRegion, nil
+		}
+	}
+	return "", fmt.Errorf("region %q is not supported, valid regions are %s, or customize the endpoints of the services to use a custom region",
+		validRegion, strings.Join(connectivity.ValidRegions(), ", "))
+}
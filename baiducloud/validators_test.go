@@ -0,0 +1,211 @@
+package baiducloud
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/baidubce/bce-sdk-go/services/scs"
+
+	"github.com/terraform-providers/terraform-provider-baiducloud/baiducloud/connectivity"
+)
+
+func TestValidateScsPurchaseCount(t *testing.T) {
+	validate := validateScsPurchaseCount()
+
+	if _, errs := validate(1, "purchase_count"); len(errs) != 0 {
+		t.Fatalf("expected purchase_count 1 to be valid, got %v", errs)
+	}
+
+	for _, count := range []int{0, 2, 10} {
+		_, errs := validate(count, "purchase_count")
+		if len(errs) != 1 {
+			t.Fatalf("expected purchase_count %d to be invalid, got %v", count, errs)
+		}
+		if !strings.Contains(errs[0].Error(), "one baiducloud_scs resource manages exactly one instance") {
+			t.Fatalf("unexpected error message for purchase_count %d: %s", count, errs[0])
+		}
+	}
+}
+
+func TestValidateScsTags(t *testing.T) {
+	validate := validateScsTags()
+
+	// the lengths are counted in characters, a Chinese character takes 3 bytes
+	valid := map[string]interface{}{"env": "test", "owner": "", strings.Repeat("键", 65): strings.Repeat("值", 65)}
+	if _, errs := validate(valid, "tags"); len(errs) != 0 {
+		t.Fatalf("expected tags to be valid, got %v", errs)
+	}
+
+	if _, errs := validate(map[string]interface{}{"env": strings.Repeat("值", 66)}, "tags"); len(errs) == 0 {
+		t.Fatalf("expected tag value of 66 characters to be invalid")
+	}
+
+	for _, key := range []string{"", "bce:project", "Baidu:owner", strings.Repeat("k", 66), strings.Repeat("键", 66), ScsDescriptionTagKey} {
+		if _, errs := validate(map[string]interface{}{key: "v"}, "tags"); len(errs) == 0 {
+			t.Fatalf("expected tag key %q to be invalid", key)
+		}
+	}
+}
+
+func TestValidateScsInstanceName(t *testing.T) {
+	validate := validateScsInstanceName()
+
+	// Chinese characters take 3 bytes each, the length must be counted in characters
+	valid := []string{"a", "redis-test_01/a.b", "缓存实例", strings.Repeat("缓", 22), "a" + strings.Repeat("缓", 64)}
+	for _, name := range valid {
+		if _, errs := validate(name, "instance_name"); len(errs) != 0 {
+			t.Fatalf("expected instance_name %q to be valid, got %v", name, errs)
+		}
+	}
+
+	invalid := []string{"", "1redis", "-redis", "redis test", "redis@test", "a" + strings.Repeat("缓", 65), strings.Repeat("a", 66)}
+	for _, name := range invalid {
+		if _, errs := validate(name, "instance_name"); len(errs) == 0 {
+			t.Fatalf("expected instance_name %q to be invalid", name)
+		}
+	}
+}
+
+func TestValidateScsPort(t *testing.T) {
+	validate := validateScsPort()
+
+	for _, port := range []int{1025, 6379, 65534} {
+		if _, errs := validate(port, "port"); len(errs) != 0 {
+			t.Fatalf("expected port %d to be valid, got %v", port, errs)
+		}
+	}
+
+	for _, port := range []int{-1, 0, 22, 1024, 65535, 65536} {
+		if _, errs := validate(port, "port"); len(errs) == 0 {
+			t.Fatalf("expected port %d to be invalid", port)
+		}
+	}
+}
+
+func TestCheckScsSubnets(t *testing.T) {
+	cases := []struct {
+		replicationNum int
+		subnets        []scs.Subnet
+		valid          bool
+	}{
+		{2, []scs.Subnet{{SubnetID: "sbn-a", ZoneName: "cn-bj-a"}}, true},
+		{2, []scs.Subnet{{SubnetID: "sbn-a", ZoneName: "cn-bj-a"}, {SubnetID: "sbn-b", ZoneName: "cn-bj-b"}}, true},
+		{1, []scs.Subnet{{SubnetID: "sbn-a", ZoneName: "cn-bj-a"}, {SubnetID: "sbn-b", ZoneName: "cn-bj-b"}}, false},
+		{2, []scs.Subnet{{SubnetID: "sbn-a", ZoneName: "cn-bj-a"}, {SubnetID: "sbn-c", ZoneName: "cn-bj-a"}}, false},
+		{2, []scs.Subnet{{SubnetID: "sbn-a", ZoneName: "cn-bj-a"}, {SubnetID: "sbn-b", ZoneName: "cn-bj-b"}, {SubnetID: "sbn-c", ZoneName: "cn-bj-c"}}, false},
+	}
+
+	for i, c := range cases {
+		err := checkScsSubnets(c.replicationNum, c.subnets)
+		if c.valid && err != nil {
+			t.Fatalf("case %d: expected subnets to be valid, got %s", i, err)
+		}
+		if !c.valid && err == nil {
+			t.Fatalf("case %d: expected subnets to be invalid", i)
+		}
+	}
+}
+
+func TestCheckScsShardNum(t *testing.T) {
+	cases := []struct {
+		clusterType string
+		shardNum    int
+		valid       bool
+	}{
+		{"master_slave", 1, true},
+		{"master_slave", 2, false},
+		{"master_slave", 0, false},
+		{"cluster", 2, true},
+		{"cluster", 128, true},
+		{"cluster", 1, false},
+		{"cluster", 3, false},
+		{"cluster", 256, false},
+	}
+
+	for _, c := range cases {
+		if err := checkScsShardNum(c.clusterType, c.shardNum); (err == nil) != c.valid {
+			t.Fatalf("expected shard_num %d of %s valid %t, got error %v", c.shardNum, c.clusterType, c.valid, err)
+		}
+	}
+}
+
+func TestCheckScsNodeType(t *testing.T) {
+	specs := &scs.GetNodeTypeListResult{
+		ClusterNodeTypeList: []scs.NodeType{{NodeType: "cache.n1.small"}, {NodeType: "cache.n1.medium"}},
+		DefaultNodeTypeList: []scs.NodeType{{NodeType: "cache.n1.micro"}, {NodeType: "cache.n1.small"}},
+	}
+	cases := []struct {
+		clusterType string
+		nodeType    string
+		valid       bool
+	}{
+		{"master_slave", "cache.n1.micro", true},
+		{"master_slave", "cache.n1.medium", false},
+		{"cluster", "cache.n1.medium", true},
+		{"cluster", "cache.n1.micro", false},
+		{"cluster", "cache.n1.unknown", false},
+	}
+
+	for _, c := range cases {
+		if err := checkScsNodeType(c.clusterType, c.nodeType, specs); (err == nil) != c.valid {
+			t.Fatalf("expected node_type %s of %s valid %t, got error %v", c.nodeType, c.clusterType, c.valid, err)
+		}
+	}
+}
+
+func TestValidateEndpoint(t *testing.T) {
+	validate := validateEndpoint()
+
+	for _, endpoint := range []string{"", "redis.bj.baidubce.com", "https://redis.bj.baidubce.com", "http://10.0.0.1:8080"} {
+		if _, errs := validate(endpoint, "scs"); len(errs) != 0 {
+			t.Fatalf("expected endpoint %q to be valid, got %v", endpoint, errs)
+		}
+	}
+
+	for _, endpoint := range []string{"ftp://redis.bj.baidubce.com", "https://", "redis.bj.baidubce.com/v1", "http://redis.bj.baidubce.com?a=b", "redis bj"} {
+		if _, errs := validate(endpoint, "scs"); len(errs) == 0 {
+			t.Fatalf("expected endpoint %q to be invalid", endpoint)
+		}
+	}
+}
+
+func TestCheckRegion(t *testing.T) {
+	t.Setenv("SCS_ENDPOINT", "")
+	cases := []struct {
+		region          string
+		configEndpoints connectivity.ConfigEndpoints
+		expected        string
+		valid           bool
+	}{
+		{"bj", nil, "bj", true},
+		{" GZ ", nil, "gz", true},
+		{"Fwh", nil, "fwh", true},
+		{"hkg", nil, "hkg", true},
+		{"SIN", nil, "sin", true},
+		{"", nil, "", true},
+		{"cn-north-1", nil, "", false},
+		{"cn-north-1", connectivity.ConfigEndpoints{connectivity.SCSCode: ""}, "", false},
+		{"private", connectivity.ConfigEndpoints{connectivity.SCSCode: "redis.private.example.com"}, "private", true},
+	}
+
+	for _, c := range cases {
+		region, err := checkRegion(c.region, c.configEndpoints)
+		if (err == nil) != c.valid {
+			t.Fatalf("expected region %q valid %t, got error %v", c.region, c.valid, err)
+		}
+		if err == nil && string(region) != c.expected {
+			t.Fatalf("expected region %q to be normalized to %q, got %q", c.region, c.expected, region)
+		}
+	}
+
+	_, err := checkRegion("cn-north-1", nil)
+	if err == nil || !strings.Contains(err.Error(), "bd, bj, cd, fsh, fwh, gz, hkg, nj, sin, su, yq") {
+		t.Fatalf("expected the error to list the valid regions, got %v", err)
+	}
+
+	// the endpoint from the environment variable customizes the region as the endpoints block does
+	t.Setenv("SCS_ENDPOINT", "redis.private.example.com")
+	if _, err := checkRegion("private", nil); err != nil {
+		t.Fatalf("expected region with an endpoint from the environment to be valid, got %v", err)
+	}
+}